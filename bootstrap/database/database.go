@@ -16,16 +16,20 @@ import (
 	addressentity "github.com/willjrcom/sales-backend-go/internal/domain/address"
 	cliententity "github.com/willjrcom/sales-backend-go/internal/domain/client"
 	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+	deliveryzoneentity "github.com/willjrcom/sales-backend-go/internal/domain/delivery_zone"
+	driversettlemententity "github.com/willjrcom/sales-backend-go/internal/domain/driver_settlement"
 	employeeentity "github.com/willjrcom/sales-backend-go/internal/domain/employee"
 	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
 	groupitementity "github.com/willjrcom/sales-backend-go/internal/domain/group_item"
 	itementity "github.com/willjrcom/sales-backend-go/internal/domain/item"
+	loyaltyentity "github.com/willjrcom/sales-backend-go/internal/domain/loyalty"
 	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
 	personentity "github.com/willjrcom/sales-backend-go/internal/domain/person"
 	processentity "github.com/willjrcom/sales-backend-go/internal/domain/process"
 	productentity "github.com/willjrcom/sales-backend-go/internal/domain/product"
 	schemaentity "github.com/willjrcom/sales-backend-go/internal/domain/schema"
 	shiftentity "github.com/willjrcom/sales-backend-go/internal/domain/shift"
+	storefrontentity "github.com/willjrcom/sales-backend-go/internal/domain/storefront"
 	tableentity "github.com/willjrcom/sales-backend-go/internal/domain/table"
 )
 
@@ -152,6 +156,10 @@ func defaultTables(ctx context.Context, db *bun.DB) error {
 		return err
 	}
 
+	if err := MigratePublicTables(ctx, db); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -182,6 +190,10 @@ func LoadAllSchemas(ctx context.Context, db *bun.DB) error {
 			return err
 		}
 
+		if err := MigrateTables(ctx, db); err != nil {
+			return err
+		}
+
 		if _, err := db.QueryContext(ctx, "CREATE UNIQUE INDEX IF NOT EXISTS idx_unique_contact ON contacts (ddd, number);"); err != nil {
 			return err
 		}
@@ -194,6 +206,33 @@ func LoadAllSchemas(ctx context.Context, db *bun.DB) error {
 	return nil
 }
 
+// GetAllSchemas returns the schemas of all companies.
+func GetAllSchemas(ctx context.Context, db *bun.DB) ([]string, error) {
+	results, err := db.QueryContext(ctx, "SELECT schema_name FROM information_schema.schemata;")
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer results.Close()
+
+	schemas := []string{}
+	for results.Next() {
+		var schemaName string
+		if err := results.Scan(&schemaName); err != nil {
+			return nil, err
+		}
+
+		if !strings.Contains(schemaName, "loja_") {
+			continue
+		}
+
+		schemas = append(schemas, schemaName)
+	}
+
+	return schemas, nil
+}
+
 func RegisterModels(ctx context.Context, db *bun.DB) error {
 	mu := sync.Mutex{}
 
@@ -216,11 +255,23 @@ func RegisterModels(ctx context.Context, db *bun.DB) error {
 	db.RegisterModel((*productentity.Category)(nil))
 	db.RegisterModel((*productentity.ProcessRule)(nil))
 	db.RegisterModel((*productentity.Product)(nil))
+	db.RegisterModel((*productentity.ComboSlot)(nil))
+	db.RegisterModel((*productentity.ProductVariant)(nil))
+	db.RegisterModel((*productentity.PriceList)(nil))
+	db.RegisterModel((*productentity.PriceRule)(nil))
+	db.RegisterModel((*productentity.ProductPriceChange)(nil))
+	db.RegisterModel((*productentity.ProductBatch)(nil))
 
 	db.RegisterModel((*addressentity.Address)(nil))
 	db.RegisterModel((*personentity.Contact)(nil))
 	db.RegisterModel((*cliententity.Client)(nil))
+	db.RegisterModel((*cliententity.ClientMerge)(nil))
 	db.RegisterModel((*employeeentity.Employee)(nil))
+	db.RegisterModel((*storefrontentity.OtpCode)(nil))
+	db.RegisterModel((*storefrontentity.Cart)(nil))
+	db.RegisterModel((*loyaltyentity.LoyaltyProgram)(nil))
+	db.RegisterModel((*loyaltyentity.Reward)(nil))
+	db.RegisterModel((*loyaltyentity.LoyaltyTransaction)(nil))
 
 	db.RegisterModel((*processentity.Process)(nil))
 	db.RegisterModel((*itementity.ItemToAdditional)(nil))
@@ -229,6 +280,8 @@ func RegisterModels(ctx context.Context, db *bun.DB) error {
 
 	db.RegisterModel((*orderentity.PickupOrder)(nil))
 	db.RegisterModel((*orderentity.DeliveryOrder)(nil))
+	db.RegisterModel((*deliveryzoneentity.DeliveryZone)(nil))
+	db.RegisterModel((*driversettlemententity.DriverSettlement)(nil))
 	db.RegisterModel((*orderentity.TableOrder)(nil))
 	db.RegisterModel((*orderentity.PaymentOrder)(nil))
 	db.RegisterModel((*orderentity.Order)(nil))
@@ -289,6 +342,36 @@ func LoadCompanyModels(ctx context.Context, db *bun.DB) error {
 		return err
 	}
 
+	if _, err := db.NewCreateTable().IfNotExists().Model((*productentity.ComboSlot)(nil)).Exec(ctx); err != nil {
+		mu.Unlock()
+		return err
+	}
+
+	if _, err := db.NewCreateTable().IfNotExists().Model((*productentity.ProductVariant)(nil)).Exec(ctx); err != nil {
+		mu.Unlock()
+		return err
+	}
+
+	if _, err := db.NewCreateTable().IfNotExists().Model((*productentity.PriceList)(nil)).Exec(ctx); err != nil {
+		mu.Unlock()
+		return err
+	}
+
+	if _, err := db.NewCreateTable().IfNotExists().Model((*productentity.PriceRule)(nil)).Exec(ctx); err != nil {
+		mu.Unlock()
+		return err
+	}
+
+	if _, err := db.NewCreateTable().IfNotExists().Model((*productentity.ProductPriceChange)(nil)).Exec(ctx); err != nil {
+		mu.Unlock()
+		return err
+	}
+
+	if _, err := db.NewCreateTable().IfNotExists().Model((*productentity.ProductBatch)(nil)).Exec(ctx); err != nil {
+		mu.Unlock()
+		return err
+	}
+
 	if _, err := db.NewCreateTable().IfNotExists().Model((*addressentity.Address)(nil)).Exec(ctx); err != nil {
 		mu.Unlock()
 		return err
@@ -309,11 +392,41 @@ func LoadCompanyModels(ctx context.Context, db *bun.DB) error {
 		return err
 	}
 
+	if _, err := db.NewCreateTable().IfNotExists().Model((*cliententity.ClientMerge)(nil)).Exec(ctx); err != nil {
+		mu.Unlock()
+		return err
+	}
+
 	if _, err := db.NewCreateTable().IfNotExists().Model((*employeeentity.Employee)(nil)).Exec(ctx); err != nil {
 		mu.Unlock()
 		return err
 	}
 
+	if _, err := db.NewCreateTable().IfNotExists().Model((*storefrontentity.OtpCode)(nil)).Exec(ctx); err != nil {
+		mu.Unlock()
+		return err
+	}
+
+	if _, err := db.NewCreateTable().IfNotExists().Model((*storefrontentity.Cart)(nil)).Exec(ctx); err != nil {
+		mu.Unlock()
+		return err
+	}
+
+	if _, err := db.NewCreateTable().IfNotExists().Model((*loyaltyentity.LoyaltyProgram)(nil)).Exec(ctx); err != nil {
+		mu.Unlock()
+		return err
+	}
+
+	if _, err := db.NewCreateTable().IfNotExists().Model((*loyaltyentity.Reward)(nil)).Exec(ctx); err != nil {
+		mu.Unlock()
+		return err
+	}
+
+	if _, err := db.NewCreateTable().IfNotExists().Model((*loyaltyentity.LoyaltyTransaction)(nil)).Exec(ctx); err != nil {
+		mu.Unlock()
+		return err
+	}
+
 	if _, err := db.NewCreateTable().IfNotExists().Model((*processentity.Process)(nil)).Exec(ctx); err != nil {
 		mu.Unlock()
 		return err
@@ -344,6 +457,16 @@ func LoadCompanyModels(ctx context.Context, db *bun.DB) error {
 		return err
 	}
 
+	if _, err := db.NewCreateTable().IfNotExists().Model((*deliveryzoneentity.DeliveryZone)(nil)).Exec(ctx); err != nil {
+		mu.Unlock()
+		return err
+	}
+
+	if _, err := db.NewCreateTable().IfNotExists().Model((*driversettlemententity.DriverSettlement)(nil)).Exec(ctx); err != nil {
+		mu.Unlock()
+		return err
+	}
+
 	if _, err := db.NewCreateTable().IfNotExists().Model((*orderentity.TableOrder)(nil)).Exec(ctx); err != nil {
 		mu.Unlock()
 		return err
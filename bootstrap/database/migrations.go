@@ -0,0 +1,144 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/uptrace/bun"
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+)
+
+// tableMigrations change the tables of the schemas already deployed,
+// create table if not exists never adds the new columns of a model.
+var tableMigrations = []string{
+	// combo products
+	"ALTER TABLE products ADD COLUMN IF NOT EXISTS type VARCHAR;",
+	"ALTER TABLE items ADD COLUMN IF NOT EXISTS combo_id UUID;",
+	"ALTER TABLE items ADD COLUMN IF NOT EXISTS combo_product_id UUID;",
+	"ALTER TABLE items ADD COLUMN IF NOT EXISTS combo_slot_id UUID;",
+	// availability schedules
+	"ALTER TABLE companies ADD COLUMN IF NOT EXISTS timezone VARCHAR;",
+	"ALTER TABLE categories ADD COLUMN IF NOT EXISTS schedules JSONB;",
+	"ALTER TABLE products ADD COLUMN IF NOT EXISTS schedules JSONB;",
+	// price lists
+	"ALTER TABLE items ADD COLUMN IF NOT EXISTS base_price DOUBLE PRECISION;",
+	"ALTER TABLE items ADD COLUMN IF NOT EXISTS price_list_id UUID;",
+	"ALTER TABLE items ADD COLUMN IF NOT EXISTS price_rule_id UUID;",
+	"ALTER TABLE items ADD COLUMN IF NOT EXISTS price_rule VARCHAR;",
+	// price history
+	"ALTER TABLE items ADD COLUMN IF NOT EXISTS product_price DOUBLE PRECISION;",
+	// company slug
+	"ALTER TABLE companies ADD COLUMN IF NOT EXISTS slug VARCHAR;",
+	// table self ordering
+	"ALTER TABLE tables ADD COLUMN IF NOT EXISTS self_order_mode VARCHAR;",
+	"ALTER TABLE tables ADD COLUMN IF NOT EXISTS public_token_nonce VARCHAR;",
+	"ALTER TABLE table_orders ADD COLUMN IF NOT EXISTS self_order BOOLEAN;",
+	"ALTER TABLE table_orders ADD COLUMN IF NOT EXISTS awaiting_approval_at TIMESTAMPTZ;",
+	// storefront online payment
+	"ALTER TABLE orders ADD COLUMN IF NOT EXISTS awaiting_payment_method VARCHAR;",
+	"ALTER TABLE orders ADD COLUMN IF NOT EXISTS awaiting_payment_at TIMESTAMPTZ;",
+	// loyalty
+	"ALTER TABLE orders ADD COLUMN IF NOT EXISTS discount DOUBLE PRECISION;",
+	"ALTER TABLE shifts ADD COLUMN IF NOT EXISTS redeems JSON;",
+	// client addresses
+	"ALTER TABLE addresses ADD COLUMN IF NOT EXISTS label VARCHAR;",
+	"ALTER TABLE addresses ADD COLUMN IF NOT EXISTS is_default BOOLEAN;",
+	// delivery zones
+	"ALTER TABLE addresses ADD COLUMN IF NOT EXISTS latitude DOUBLE PRECISION;",
+	"ALTER TABLE addresses ADD COLUMN IF NOT EXISTS longitude DOUBLE PRECISION;",
+	"ALTER TABLE delivery_orders ADD COLUMN IF NOT EXISTS delivery_zone_id UUID;",
+	"ALTER TABLE delivery_orders ADD COLUMN IF NOT EXISTS min_order_value DOUBLE PRECISION;",
+	"ALTER TABLE delivery_orders ADD COLUMN IF NOT EXISTS estimated_time BIGINT;",
+	// delivery dispatch board
+	"ALTER TABLE delivery_orders ADD COLUMN IF NOT EXISTS batch_id UUID;",
+	"ALTER TABLE delivery_orders ADD COLUMN IF NOT EXISTS stop BIGINT;",
+	// driver app
+	"ALTER TABLE delivery_orders ADD COLUMN IF NOT EXISTS recipient_name VARCHAR;",
+	"ALTER TABLE delivery_orders ADD COLUMN IF NOT EXISTS photo_key VARCHAR;",
+	"ALTER TABLE delivery_orders ADD COLUMN IF NOT EXISTS accepted_at TIMESTAMPTZ;",
+	"ALTER TABLE delivery_orders ADD COLUMN IF NOT EXISTS picked_up_at TIMESTAMPTZ;",
+	"ALTER TABLE payment_orders ADD COLUMN IF NOT EXISTS driver_id UUID;",
+	// driver settlements
+	"ALTER TABLE delivery_orders ADD COLUMN IF NOT EXISTS settlement_id UUID;",
+	"ALTER TABLE driver_settlements ADD COLUMN IF NOT EXISTS other_collected DOUBLE PRECISION;",
+	// delivery state machine, shipped deliveries were renamed to dispatched
+	"ALTER TABLE delivery_orders ADD COLUMN IF NOT EXISTS failure_reason VARCHAR;",
+	"ALTER TABLE delivery_orders ADD COLUMN IF NOT EXISTS transitions JSONB;",
+	"ALTER TABLE payment_orders ADD COLUMN IF NOT EXISTS refunded_at TIMESTAMPTZ;",
+	"UPDATE delivery_orders SET status = 'Dispatched' WHERE status = 'Shipped';",
+}
+
+// publicTableMigrations change the tables of the public schema, shared by every company.
+var publicTableMigrations = []string{
+	"ALTER TABLE companies ADD COLUMN IF NOT EXISTS timezone VARCHAR;",
+	"ALTER TABLE companies ADD COLUMN IF NOT EXISTS slug VARCHAR;",
+}
+
+// companySlugIndex is created after the slugs are filled, the name is the one of the unique constraint of new tables.
+const companySlugIndex = "CREATE UNIQUE INDEX IF NOT EXISTS companies_slug_key ON companies (slug);"
+
+func MigrateTables(ctx context.Context, db *bun.DB) error {
+	if err := ChangeSchema(ctx, db); err != nil {
+		return err
+	}
+
+	if err := migrate(ctx, db, tableMigrations); err != nil {
+		return err
+	}
+
+	return migrateCompanySlugs(ctx, db)
+}
+
+func MigratePublicTables(ctx context.Context, db *bun.DB) error {
+	if err := ChangeToPublicSchema(ctx, db); err != nil {
+		return err
+	}
+
+	if err := migrate(ctx, db, publicTableMigrations); err != nil {
+		return err
+	}
+
+	return migrateCompanySlugs(ctx, db)
+}
+
+func migrate(ctx context.Context, db *bun.DB, migrations []string) error {
+	for _, migration := range migrations {
+		if _, err := db.ExecContext(ctx, migration); err != nil {
+			return errors.New("Failed to migrate table " + migration + err.Error())
+		}
+	}
+
+	return nil
+}
+
+// migrateCompanySlugs fills the slug of the companies created before it existed,
+// the suffix is the short id of the schema name, like on new companies.
+func migrateCompanySlugs(ctx context.Context, db *bun.DB) error {
+	companies := []companyentity.Company{}
+
+	if err := db.NewSelect().Model(&companies).Column("id", "schema_name", "trade_name").Where("slug IS NULL OR slug = ''").Scan(ctx); err != nil {
+		return errors.New("Failed to select companies without slug " + err.Error())
+	}
+
+	for _, company := range companies {
+		lowerName := strings.ReplaceAll(strings.ToLower(company.TradeName), " ", "_")
+		suffix, found := strings.CutPrefix(company.SchemaName, "loja_"+lowerName+"_")
+
+		if !found {
+			suffix = strings.Split(company.ID.String(), "-")[0]
+		}
+
+		slug := companyentity.NewSlug(company.TradeName, suffix)
+
+		if _, err := db.NewUpdate().Model(&company).Set("slug = ?", slug).Where("id = ?", company.ID).Exec(ctx); err != nil {
+			return errors.New("Failed to migrate slug of company " + company.SchemaName + err.Error())
+		}
+	}
+
+	if _, err := db.ExecContext(ctx, companySlugIndex); err != nil {
+		return errors.New("Failed to migrate table " + companySlugIndex + err.Error())
+	}
+
+	return nil
+}
@@ -46,7 +46,7 @@ func (c *ServerChi) middlewareAuthUser(next http.Handler) http.Handler {
 				return
 			}
 
-			token, err := jwtservice.ValidateToken(ctx, tokenString)
+			token, err := jwtservice.ValidateIDToken(ctx, tokenString)
 
 			if err != nil {
 				jsonpkg.ResponseJson(w, r, http.StatusUnauthorized, jsonpkg.Error{Message: err.Error()})
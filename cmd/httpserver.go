@@ -6,20 +6,27 @@ package cmd
 import (
 	"context"
 	"flag"
+	"os"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/willjrcom/sales-backend-go/bootstrap/database"
 	"github.com/willjrcom/sales-backend-go/bootstrap/server"
 	handlerimpl "github.com/willjrcom/sales-backend-go/internal/infra/handler"
+	"github.com/willjrcom/sales-backend-go/internal/infra/job"
 	addressrepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/address"
 	categoryrepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/category_product"
 	clientrepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/client"
 	companyrepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/company"
 	contactrepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/contact"
+	deliveryzonerepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/delivery_zone"
+	driversettlementrepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/driver_settlement"
 	employeerepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/employee"
 	groupitemrepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/group_item"
 	itemrepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/item"
+	loyaltyrepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/loyalty"
 	orderrepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/order"
+	pricelistrepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/price_list"
 	processrepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/process"
 	processrulerepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/process_rule"
 	productrepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/product"
@@ -27,27 +34,43 @@ import (
 	schemarepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/schema"
 	shiftrepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/shift"
 	sizerepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/size_category"
+	storefrontrepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/storefront"
 	tablerepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/table"
 	userrepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/user"
+	"github.com/willjrcom/sales-backend-go/internal/infra/service/cep"
+	"github.com/willjrcom/sales-backend-go/internal/infra/service/cnpj"
 	schemaservice "github.com/willjrcom/sales-backend-go/internal/infra/service/header"
+	"github.com/willjrcom/sales-backend-go/internal/infra/service/s3"
+	smsservice "github.com/willjrcom/sales-backend-go/internal/infra/service/sms"
+	addressusecases "github.com/willjrcom/sales-backend-go/internal/usecases/address"
+	catalogusecases "github.com/willjrcom/sales-backend-go/internal/usecases/catalog"
 	categoryproductusecases "github.com/willjrcom/sales-backend-go/internal/usecases/category_product"
 	clientusecases "github.com/willjrcom/sales-backend-go/internal/usecases/client"
 	companyusecases "github.com/willjrcom/sales-backend-go/internal/usecases/company"
 	contactusecases "github.com/willjrcom/sales-backend-go/internal/usecases/contact"
 	deliveryorderusecases "github.com/willjrcom/sales-backend-go/internal/usecases/delivery_order"
+	deliveryzoneusecases "github.com/willjrcom/sales-backend-go/internal/usecases/delivery_zone"
+	driversettlementusecases "github.com/willjrcom/sales-backend-go/internal/usecases/driver_settlement"
 	employeeusecases "github.com/willjrcom/sales-backend-go/internal/usecases/employee"
+	etausecases "github.com/willjrcom/sales-backend-go/internal/usecases/eta"
 	groupitemusecases "github.com/willjrcom/sales-backend-go/internal/usecases/group_item"
 	itemusecases "github.com/willjrcom/sales-backend-go/internal/usecases/item"
+	loyaltyusecases "github.com/willjrcom/sales-backend-go/internal/usecases/loyalty"
+	menuusecases "github.com/willjrcom/sales-backend-go/internal/usecases/menu"
 	orderusecases "github.com/willjrcom/sales-backend-go/internal/usecases/order"
 	pickuporderusecases "github.com/willjrcom/sales-backend-go/internal/usecases/pickup_order"
+	pricelistusecases "github.com/willjrcom/sales-backend-go/internal/usecases/price_list"
 	processusecases "github.com/willjrcom/sales-backend-go/internal/usecases/process"
 	processRuleusecases "github.com/willjrcom/sales-backend-go/internal/usecases/process_category"
 	productusecases "github.com/willjrcom/sales-backend-go/internal/usecases/product"
 	quantityusecases "github.com/willjrcom/sales-backend-go/internal/usecases/quantity_category"
+	selforderusecases "github.com/willjrcom/sales-backend-go/internal/usecases/self_order"
 	shiftusecases "github.com/willjrcom/sales-backend-go/internal/usecases/shift"
 	sizeusecases "github.com/willjrcom/sales-backend-go/internal/usecases/size_category"
+	storefrontusecases "github.com/willjrcom/sales-backend-go/internal/usecases/storefront"
 	tableusecases "github.com/willjrcom/sales-backend-go/internal/usecases/table"
 	tableorderusecases "github.com/willjrcom/sales-backend-go/internal/usecases/table_order"
+	trackingusecases "github.com/willjrcom/sales-backend-go/internal/usecases/tracking"
 	userusecases "github.com/willjrcom/sales-backend-go/internal/usecases/user"
 )
 
@@ -58,6 +81,11 @@ var HttpserverCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, _ []string) {
 		cmd.Println("httpserver called")
 		port, _ := cmd.Flags().GetString("port")
+		cnpjProviderName, _ := cmd.Flags().GetString("cnpj-provider")
+		cepProviderName, _ := cmd.Flags().GetString("cep-provider")
+		storageProviderName, _ := cmd.Flags().GetString("storage-provider")
+		selfOrderURL, _ := cmd.Flags().GetString("self-order-url")
+		smsProviderName, _ := cmd.Flags().GetString("sms-provider")
 
 		flag.Parse()
 		ctx := context.Background()
@@ -72,17 +100,25 @@ var HttpserverCmd = &cobra.Command{
 
 		// Load repositories
 		productRepo := productrepositorybun.NewProductRepositoryBun(db)
+		productPriceChangeRepo := productrepositorybun.NewProductPriceChangeRepositoryBun(db)
 		categoryRepo := categoryrepositorybun.NewCategoryProductRepositoryBun(db)
 		sizeRepo := sizerepositorybun.NewSizeCategoryRepositoryBun(db)
 		quantityRepo := quantityrepositorybun.NewQuantityCategoryRepositoryBun(db)
 		processRuleRepo := processrulerepositorybun.NewProcessRuleCategoryRepositoryBun(db)
+		priceListRepo := pricelistrepositorybun.NewPriceListRepositoryBun(db)
 
 		clientRepo := clientrepositorybun.NewClientRepositoryBun(db)
 		contactRepo := contactrepositorybun.NewContactRepositoryBun(ctx, db)
 		addressRepo := addressrepositorybun.NewAddressRepositoryBun(db)
+		loyaltyProgramRepo := loyaltyrepositorybun.NewProgramRepositoryBun(db)
+		loyaltyRewardRepo := loyaltyrepositorybun.NewRewardRepositoryBun(db)
+		loyaltyTransactionRepo := loyaltyrepositorybun.NewTransactionRepositoryBun(db)
 
 		orderRepo := orderrepositorybun.NewOrderRepositoryBun(db)
 		deliveryOrderRepo := orderrepositorybun.NewDeliveryOrderRepositoryBun(db)
+		etaRepo := orderrepositorybun.NewEtaRepositoryBun(db)
+		deliveryZoneRepo := deliveryzonerepositorybun.NewDeliveryZoneRepositoryBun(db)
+		driverSettlementRepo := driversettlementrepositorybun.NewDriverSettlementRepositoryBun(db)
 		pickupOrderRepo := orderrepositorybun.NewPickupOrderRepositoryBun(db)
 		tableOrderRepo := orderrepositorybun.NewTableOrderRepositoryBun(db)
 		processRepo := processrepositorybun.NewProcessRepositoryBun(db)
@@ -97,31 +133,90 @@ var HttpserverCmd = &cobra.Command{
 		companyRepo := companyrepositorybun.NewCompanyRepositoryBun(db)
 		userRepo := userrepositorybun.NewUserRepositoryBun(db)
 
+		otpRepo := storefrontrepositorybun.NewOtpRepositoryBun(db)
+		cartRepo := storefrontrepositorybun.NewCartRepositoryBun(db)
+
+		// Load providers
+		var smsProvider smsservice.Provider
+		switch smsProviderName {
+		case "twilio":
+			smsProvider = smsservice.NewTwilioProvider(os.Getenv("TWILIO_ACCOUNT_SID"), os.Getenv("TWILIO_AUTH_TOKEN"), os.Getenv("TWILIO_FROM"), 10*time.Second)
+		case "fake":
+			smsProvider = smsservice.NewFakeProvider()
+		default:
+			panic("unknown sms provider: " + smsProviderName)
+		}
+
+		var cnpjProvider cnpj.CnpjProvider
+		switch cnpjProviderName {
+		case "receitaws":
+			cnpjProvider = cnpj.NewCachedProvider(cnpj.NewReceitaWSProvider(10*time.Second), 24*time.Hour)
+		case "fixture":
+			cnpjProvider = cnpj.NewFixtureProvider(cnpj.DefaultFixtures...)
+		default:
+			panic("unknown cnpj provider: " + cnpjProviderName)
+		}
+
+		var cepProvider cep.CepProvider
+		switch cepProviderName {
+		case "viacep":
+			cepProvider = cep.NewViaCepProvider(5 * time.Second)
+		case "fixture":
+			cepProvider = cep.NewFixtureProvider(cep.DefaultFixtures...)
+		default:
+			panic("unknown cep provider: " + cepProviderName)
+		}
+
+		var storage s3.Storage
+		switch storageProviderName {
+		case "s3":
+			storage = s3.NewBucketStorage(s3.DefaultBucket)
+		case "fake":
+			storage = s3.NewFakeStorage()
+		default:
+			panic("unknown storage provider: " + storageProviderName)
+		}
+
 		// Load services
-		productService := productusecases.NewService(productRepo, categoryRepo)
+		addressService := addressusecases.NewService(addressRepo, cepProvider)
+		productService := productusecases.NewService(productRepo, categoryRepo, companyRepo, productPriceChangeRepo)
 		categoryProductService := categoryproductusecases.NewService(categoryRepo)
 		sizeService := sizeusecases.NewService(sizeRepo, categoryRepo)
 		quantityService := quantityusecases.NewService(quantityRepo, categoryRepo)
 		processRuleService := processRuleusecases.NewService(processRuleRepo)
+		priceListService := pricelistusecases.NewService(priceListRepo)
+		catalogService := catalogusecases.NewService(categoryRepo, productRepo)
+		menuService := menuusecases.NewService(companyRepo, categoryRepo, productRepo)
 
-		clientService := clientusecases.NewService(clientRepo, contactRepo)
+		clientService := clientusecases.NewService(clientRepo, contactRepo, addressRepo, orderRepo)
 		employeeService := employeeusecases.NewService(employeeRepo, contactRepo)
 		contactService := contactusecases.NewService(contactRepo)
 
-		itemService := itemusecases.NewService(itemRepo, groupItemRepo, orderRepo, productRepo, quantityRepo)
+		itemService := itemusecases.NewService(itemRepo, groupItemRepo, orderRepo, productRepo, quantityRepo, companyRepo, priceListRepo)
 		groupService := groupitemusecases.NewService(itemRepo, groupItemRepo, productRepo)
-		orderService := orderusecases.NewService(orderRepo, shiftRepo, groupService)
+		loyaltyService := loyaltyusecases.NewService(loyaltyProgramRepo, loyaltyRewardRepo, loyaltyTransactionRepo, clientRepo, orderRepo, shiftRepo, productRepo)
+		etaService := etausecases.NewService(etaRepo, categoryRepo, 30*time.Second)
+		orderService := orderusecases.NewService(orderRepo, shiftRepo, groupService, loyaltyService, etaService)
 		pickupOrderService := pickuporderusecases.NewService(pickupOrderRepo, orderService)
-		deliveryOrderService := deliveryorderusecases.NewService(deliveryOrderRepo, addressRepo, clientRepo, orderRepo, employeeRepo, orderService)
+		deliveryZoneService := deliveryzoneusecases.NewService(deliveryZoneRepo, addressRepo, companyRepo)
+		deliveryOrderService := deliveryorderusecases.NewService(deliveryOrderRepo, addressRepo, clientRepo, orderRepo, employeeRepo, orderService, deliveryZoneService, storage)
+		driverSettlementService := driversettlementusecases.NewService(driverSettlementRepo, employeeRepo, shiftRepo)
 		tableOrderService := tableorderusecases.NewService(tableOrderRepo, tableRepo, orderService)
 		processService := processusecases.NewService(processRepo)
+		selfOrderService := selforderusecases.NewService(tableRepo, tableOrderRepo, tableOrderService, orderService, itemService, menuService)
 
-		tableService := tableusecases.NewService(tableRepo)
+		storefrontService := storefrontusecases.NewService(companyRepo, otpRepo, cartRepo, clientRepo, contactRepo, addressRepo, productRepo, quantityRepo, smsProvider, orderService, itemService, deliveryOrderService, pickupOrderService, deliveryZoneService)
+		trackingService := trackingusecases.NewService(orderRepo, employeeRepo, etaService)
+
+		tableService := tableusecases.NewService(tableRepo, selfOrderURL)
 		shiftService := shiftusecases.NewService(shiftRepo)
 
 		schemaService := schemaservice.NewService(schemaRepo)
 		userService := userusecases.NewService(userRepo)
-		companyService := companyusecases.NewService(companyRepo, addressRepo, *schemaService, userRepo, *userService)
+		companyService := companyusecases.NewService(companyRepo, addressRepo, *schemaService, userRepo, *userService, cnpjProvider)
+
+		// Load jobs
+		go job.RunForAllSchemas(ctx, db, time.Minute, "scheduled price changes", productService.ApplyScheduledPriceChanges)
 
 		// Load handlers
 		productHandler := handlerimpl.NewHandlerProduct(productService)
@@ -129,15 +224,26 @@ var HttpserverCmd = &cobra.Command{
 		sizeHandler := handlerimpl.NewHandlerSizeCategory(sizeService)
 		quantityHandler := handlerimpl.NewHandlerQuantityCategory(quantityService)
 		processRuleHandler := handlerimpl.NewHandlerProcessRuleCategory(processRuleService)
+		priceListHandler := handlerimpl.NewHandlerPriceList(priceListService)
+		catalogHandler := handlerimpl.NewHandlerCatalog(catalogService)
+		menuHandler := handlerimpl.NewHandlerMenu(menuService)
 
 		clientHandler := handlerimpl.NewHandlerClient(clientService)
 		employeeHandler := handlerimpl.NewHandlerEmployee(employeeService)
 		contactHandler := handlerimpl.NewHandlerContactPerson(contactService)
+		addressHandler := handlerimpl.NewHandlerAddress(addressService)
+		loyaltyHandler := handlerimpl.NewHandlerLoyalty(loyaltyService)
 
 		orderHandler := handlerimpl.NewHandlerOrder(orderService)
 		pickupOrderHandler := handlerimpl.NewHandlerPickupOrder(pickupOrderService)
 		deliveryOrderHandler := handlerimpl.NewHandlerDeliveryOrder(deliveryOrderService)
+		deliveryZoneHandler := handlerimpl.NewHandlerDeliveryZone(deliveryZoneService)
+		driverHandler := handlerimpl.NewHandlerDriver(deliveryOrderService)
+		driverSettlementHandler := handlerimpl.NewHandlerDriverSettlement(driverSettlementService)
 		tableOrderHandler := handlerimpl.NewHandlerTableOrder(tableOrderService)
+		selfOrderHandler := handlerimpl.NewHandlerSelfOrder(selfOrderService)
+		storefrontHandler := handlerimpl.NewHandlerStorefront(storefrontService)
+		trackingHandler := handlerimpl.NewHandlerTracking(trackingService)
 		processHandler := handlerimpl.NewHandlerProcess(processService)
 		itemHandler := handlerimpl.NewHandlerItem(itemService)
 		groupHandler := handlerimpl.NewHandlerGroupItem(groupService)
@@ -153,15 +259,26 @@ var HttpserverCmd = &cobra.Command{
 		server.AddHandler(sizeHandler)
 		server.AddHandler(quantityHandler)
 		server.AddHandler(processRuleHandler)
+		server.AddHandler(priceListHandler)
+		server.AddHandler(catalogHandler)
+		server.AddHandler(menuHandler)
 
 		server.AddHandler(clientHandler)
 		server.AddHandler(employeeHandler)
 		server.AddHandler(contactHandler)
+		server.AddHandler(addressHandler)
+		server.AddHandler(loyaltyHandler)
 
 		server.AddHandler(orderHandler)
 		server.AddHandler(pickupOrderHandler)
 		server.AddHandler(deliveryOrderHandler)
+		server.AddHandler(deliveryZoneHandler)
+		server.AddHandler(driverHandler)
+		server.AddHandler(driverSettlementHandler)
 		server.AddHandler(tableOrderHandler)
+		server.AddHandler(selfOrderHandler)
+		server.AddHandler(storefrontHandler)
+		server.AddHandler(trackingHandler)
 		server.AddHandler(processHandler)
 		server.AddHandler(itemHandler)
 		server.AddHandler(groupHandler)
@@ -2,12 +2,23 @@ package addressentity
 
 import (
 	"errors"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/uptrace/bun"
 	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
 )
 
+var (
+	ErrStateInvalid = errors.New("state must be a brazilian uf")
+)
+
+// States are the abbreviations of the brazilian federative units.
+var States = []string{
+	"AC", "AL", "AP", "AM", "BA", "CE", "DF", "ES", "GO", "MA", "MT", "MS", "MG", "PA",
+	"PB", "PR", "PE", "PI", "RJ", "RN", "RS", "RO", "RR", "SC", "SP", "SE", "TO",
+}
+
 type Address struct {
 	entity.Entity
 	bun.BaseModel `bun:"table:addresses"`
@@ -25,6 +36,10 @@ type AddressCommonAttributes struct {
 	State        string    `bun:"state,notnull" json:"state"`
 	Cep          string    `bun:"cep" json:"cep"`
 	DeliveryTax  float64   `bun:"delivery_tax,notnull" json:"delivery_tax"`
+	Label        string    `bun:"label" json:"label,omitempty"`
+	IsDefault    bool      `bun:"is_default" json:"is_default"`
+	Latitude     *float64  `bun:"latitude" json:"latitude,omitempty"`
+	Longitude    *float64  `bun:"longitude" json:"longitude,omitempty"`
 }
 
 type PatchAddress struct {
@@ -37,8 +52,13 @@ type PatchAddress struct {
 	State        *string  `json:"state"`
 	Cep          *string  `json:"cep"`
 	DeliveryTax  *float64 `json:"delivery_tax"`
+	Label        *string  `json:"label"`
+	IsDefault    *bool    `json:"is_default"`
+	Latitude     *float64 `json:"latitude"`
+	Longitude    *float64 `json:"longitude"`
 }
 
+// Validate checks the required fields, the state is saved as the uppercase uf.
 func (a *Address) Validate() error {
 	if a.Street == "" {
 		return errors.New("street is required")
@@ -55,6 +75,10 @@ func (a *Address) Validate() error {
 	if a.State == "" {
 		return errors.New("state is required")
 	}
+	if err := ValidateState(a.State); err != nil {
+		return err
+	}
+	a.State = strings.ToUpper(strings.TrimSpace(a.State))
 	return nil
 }
 
@@ -64,3 +88,15 @@ func NewAddress(addressCommonAttributes *AddressCommonAttributes) *Address {
 		AddressCommonAttributes: *addressCommonAttributes,
 	}
 }
+
+func ValidateState(state string) error {
+	state = strings.ToUpper(strings.TrimSpace(state))
+
+	for _, uf := range States {
+		if uf == state {
+			return nil
+		}
+	}
+
+	return ErrStateInvalid
+}
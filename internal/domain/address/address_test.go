@@ -0,0 +1,18 @@
+package addressentity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateState(t *testing.T) {
+	address := &Address{AddressCommonAttributes: AddressCommonAttributes{Street: "Rua A", Number: "1", Neighborhood: "Centro", City: "São Paulo", State: "sp"}}
+	assert.Nil(t, address.Validate())
+	assert.Equal(t, "SP", address.State)
+
+	address.State = "XX"
+	assert.EqualError(t, address.Validate(), ErrStateInvalid.Error())
+
+	assert.Len(t, States, 27)
+}
@@ -7,4 +7,5 @@ type Repository interface {
 	UpdateAddress(ctx context.Context, address *Address) error
 	DeleteAddress(ctx context.Context, id string) error
 	GetAddressById(ctx context.Context, id string) (*Address, error)
+	GetDeliveryTaxByNeighborhood(ctx context.Context, neighborhood string, city string) (float64, error)
 }
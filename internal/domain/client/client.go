@@ -1,11 +1,89 @@
 package cliententity
 
 import (
+	"errors"
+
+	"github.com/google/uuid"
 	"github.com/uptrace/bun"
+	addressentity "github.com/willjrcom/sales-backend-go/internal/domain/address"
 	personentity "github.com/willjrcom/sales-backend-go/internal/domain/person"
 )
 
+var (
+	ErrAddressNotFound = errors.New("address not found in client")
+	ErrContactNotFound = errors.New("contact not found in client")
+)
+
 type Client struct {
 	bun.BaseModel `bun:"table:clients"`
 	personentity.Person
+	Addresses []addressentity.Address `bun:"rel:has-many,join:id=object_id" json:"addresses,omitempty"`
+	Contacts  []personentity.Contact  `bun:"rel:has-many,join:id=object_id" json:"contacts,omitempty"`
+}
+
+// LoadDefaults sets the address and contact of the client from its addresses and contacts,
+// the address is the default one or the first one when none is default.
+func (c *Client) LoadDefaults() {
+	c.Address = nil
+	c.Contact = nil
+
+	for i := range c.Addresses {
+		if c.Address == nil || (c.Addresses[i].IsDefault && !c.Address.IsDefault) {
+			c.Address = &c.Addresses[i]
+		}
+	}
+
+	if len(c.Contacts) > 0 {
+		c.Contact = &c.Contacts[0]
+	}
+}
+
+func (c *Client) GetAddress(id uuid.UUID) (*addressentity.Address, error) {
+	for i := range c.Addresses {
+		if c.Addresses[i].ID == id {
+			return &c.Addresses[i], nil
+		}
+	}
+
+	return nil, ErrAddressNotFound
+}
+
+func (c *Client) GetContact(id uuid.UUID) (*personentity.Contact, error) {
+	for i := range c.Contacts {
+		if c.Contacts[i].ID == id {
+			return &c.Contacts[i], nil
+		}
+	}
+
+	return nil, ErrContactNotFound
+}
+
+// DeliveryAddress returns the address chosen for the delivery, the default address when none is chosen.
+func (c *Client) DeliveryAddress(id *uuid.UUID) (*addressentity.Address, error) {
+	if id != nil {
+		return c.GetAddress(*id)
+	}
+
+	if c.Address == nil {
+		return nil, ErrAddressNotFound
+	}
+
+	return c.Address, nil
+}
+
+// SetDefaultAddress makes the address the only default address of the client.
+func (c *Client) SetDefaultAddress(id uuid.UUID) error {
+	address, err := c.GetAddress(id)
+
+	if err != nil {
+		return err
+	}
+
+	for i := range c.Addresses {
+		c.Addresses[i].IsDefault = false
+	}
+
+	address.IsDefault = true
+	c.Address = address
+	return nil
 }
@@ -0,0 +1,46 @@
+package cliententity
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	addressentity "github.com/willjrcom/sales-backend-go/internal/domain/address"
+	personentity "github.com/willjrcom/sales-backend-go/internal/domain/person"
+)
+
+func TestClientDefaultAddress(t *testing.T) {
+	home := addressentity.NewAddress(&addressentity.AddressCommonAttributes{Label: "home"})
+	work := addressentity.NewAddress(&addressentity.AddressCommonAttributes{Label: "work", IsDefault: true})
+	phone := personentity.NewContact(personentity.ContactCommonAttributes{Ddd: "11", Number: "999999999"})
+
+	client := &Client{Addresses: []addressentity.Address{*home, *work}, Contacts: []personentity.Contact{*phone}}
+	client.LoadDefaults()
+	assert.Equal(t, work.ID, client.Address.ID)
+	assert.Equal(t, phone.ID, client.Contact.ID)
+
+	address, err := client.DeliveryAddress(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, work.ID, address.ID)
+
+	address, err = client.DeliveryAddress(&home.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, home.ID, address.ID)
+
+	unknown := uuid.New()
+	_, err = client.DeliveryAddress(&unknown)
+	assert.EqualError(t, err, ErrAddressNotFound.Error())
+
+	assert.Nil(t, client.SetDefaultAddress(home.ID))
+	assert.Equal(t, home.ID, client.Address.ID)
+	assert.True(t, client.Addresses[0].IsDefault)
+	assert.False(t, client.Addresses[1].IsDefault)
+}
+
+func TestClientWithoutAddress(t *testing.T) {
+	client := &Client{}
+	client.LoadDefaults()
+
+	_, err := client.DeliveryAddress(nil)
+	assert.EqualError(t, err, ErrAddressNotFound.Error())
+}
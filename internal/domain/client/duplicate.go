@@ -0,0 +1,232 @@
+package cliententity
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/google/uuid"
+)
+
+// Weights of each matching field, a pair of clients is a duplicate when the sum reaches the minimum score.
+const (
+	cpfWeight     = 1.0
+	phoneWeight   = 0.6
+	emailWeight   = 0.5
+	nameWeight    = 0.3
+	addressWeight = 0.2
+
+	// DefaultDuplicateScore finds pairs matching at least by phone, or by email and name.
+	DefaultDuplicateScore = 0.6
+	minNameSimilarity     = 0.8
+	phoneSuffixLength     = 8
+)
+
+const (
+	MatchCpf     = "cpf"
+	MatchPhone   = "phone"
+	MatchEmail   = "email"
+	MatchName    = "name"
+	MatchAddress = "address"
+)
+
+var accentReplacer = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ã", "a", "ä", "a",
+	"é", "e", "è", "e", "ê", "e", "ë", "e",
+	"í", "i", "ì", "i", "î", "i", "ï", "i",
+	"ó", "o", "ò", "o", "ô", "o", "õ", "o", "ö", "o",
+	"ú", "u", "ù", "u", "û", "u", "ü", "u",
+	"ç", "c", "ñ", "n",
+)
+
+// DuplicateCandidate is a pair of clients probably registered twice, scored from 0 to 1.
+type DuplicateCandidate struct {
+	ClientID    uuid.UUID `json:"client_id"`
+	DuplicateID uuid.UUID `json:"duplicate_id"`
+	Score       float64   `json:"score"`
+	Matches     []string  `json:"matches"`
+}
+
+// FindDuplicates compares every pair of clients and returns the pairs with at least the minimum score.
+func FindDuplicates(clients []Client, minScore float64) []DuplicateCandidate {
+	keys := make([]duplicateKeys, len(clients))
+	for i := range clients {
+		keys[i] = newDuplicateKeys(&clients[i])
+	}
+
+	candidates := []DuplicateCandidate{}
+
+	for i := range clients {
+		for j := i + 1; j < len(clients); j++ {
+			score, matches := keys[i].compare(&keys[j])
+
+			if score >= minScore {
+				candidates = append(candidates, DuplicateCandidate{
+					ClientID:    clients[i].ID,
+					DuplicateID: clients[j].ID,
+					Score:       score,
+					Matches:     matches,
+				})
+			}
+		}
+	}
+
+	return candidates
+}
+
+type duplicateKeys struct {
+	cpf       string
+	email     string
+	name      string
+	phones    map[string]bool
+	addresses map[string]bool
+}
+
+func newDuplicateKeys(client *Client) duplicateKeys {
+	keys := duplicateKeys{
+		cpf:       NormalizeDigits(client.Cpf),
+		email:     strings.ToLower(strings.TrimSpace(client.Email)),
+		name:      NormalizeName(client.Name),
+		phones:    map[string]bool{},
+		addresses: map[string]bool{},
+	}
+
+	for _, contact := range client.Contacts {
+		if phone := NormalizePhone(contact.Ddd, contact.Number); phone != "" {
+			keys.phones[phone] = true
+		}
+	}
+
+	if client.Contact != nil {
+		if phone := NormalizePhone(client.Contact.Ddd, client.Contact.Number); phone != "" {
+			keys.phones[phone] = true
+		}
+	}
+
+	for _, address := range client.Addresses {
+		keys.addresses[NormalizeName(address.Street+" "+address.Number+" "+address.Neighborhood)] = true
+	}
+
+	return keys
+}
+
+func (k *duplicateKeys) compare(other *duplicateKeys) (float64, []string) {
+	score := 0.0
+	matches := []string{}
+
+	if k.cpf != "" && k.cpf == other.cpf {
+		score += cpfWeight
+		matches = append(matches, MatchCpf)
+	}
+
+	for phone := range k.phones {
+		if other.phones[phone] {
+			score += phoneWeight
+			matches = append(matches, MatchPhone)
+			break
+		}
+	}
+
+	if k.email != "" && k.email == other.email {
+		score += emailWeight
+		matches = append(matches, MatchEmail)
+	}
+
+	if similarity := NameSimilarity(k.name, other.name); similarity >= minNameSimilarity {
+		score += nameWeight * similarity
+		matches = append(matches, MatchName)
+	}
+
+	for address := range k.addresses {
+		if other.addresses[address] {
+			score += addressWeight
+			matches = append(matches, MatchAddress)
+			break
+		}
+	}
+
+	if score > 1 {
+		score = 1
+	}
+
+	return score, matches
+}
+
+func NormalizeDigits(text string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsDigit(r) {
+			return r
+		}
+		return -1
+	}, text)
+}
+
+// NormalizePhone keeps the ddd and the last digits of the number, ignoring the extra mobile nine.
+// The same number on another ddd is another phone.
+func NormalizePhone(ddd string, number string) string {
+	digits := NormalizeDigits(number)
+
+	if len(digits) > phoneSuffixLength {
+		digits = digits[len(digits)-phoneSuffixLength:]
+	}
+
+	if digits == "" {
+		return ""
+	}
+
+	return NormalizeDigits(ddd) + digits
+}
+
+// NormalizeName lowers the text, removes accents and punctuation and collapses the spaces.
+func NormalizeName(name string) string {
+	name = accentReplacer.Replace(strings.ToLower(name))
+
+	name = strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return ' '
+	}, name)
+
+	return strings.Join(strings.Fields(name), " ")
+}
+
+// NameSimilarity returns 1 for equal names and 0 for completely different names, based on the edit distance.
+func NameSimilarity(a, b string) float64 {
+	if a == "" || b == "" {
+		return 0
+	}
+
+	ra, rb := []rune(a), []rune(b)
+	longest := len(ra)
+	if len(rb) > longest {
+		longest = len(rb)
+	}
+
+	return 1 - float64(levenshtein(ra, rb))/float64(longest)
+}
+
+func levenshtein(a, b []rune) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+
+		previous, current = current, previous
+	}
+
+	return previous[len(b)]
+}
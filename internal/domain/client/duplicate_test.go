@@ -0,0 +1,83 @@
+package cliententity
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	addressentity "github.com/willjrcom/sales-backend-go/internal/domain/address"
+	personentity "github.com/willjrcom/sales-backend-go/internal/domain/person"
+)
+
+func newTestClient(name string, ddd string, number string) Client {
+	client := Client{}
+	client.ID = uuid.New()
+	client.Name = name
+	client.Contacts = []personentity.Contact{{ContactCommonAttributes: personentity.ContactCommonAttributes{Ddd: ddd, Number: number}}}
+	return client
+}
+
+func TestNormalize(t *testing.T) {
+	assert.Equal(t, "1187654321", NormalizePhone("11", "98765-4321"))
+	assert.Equal(t, "1187654321", NormalizePhone("(11)", "8765 4321"))
+	assert.Equal(t, "", NormalizePhone("11", ""))
+	assert.Equal(t, "12345678909", NormalizeDigits("123.456.789-09"))
+	assert.Equal(t, "joao da silva", NormalizeName("  João da  Silva. "))
+	assert.Equal(t, 1.0, NameSimilarity("maria", "maria"))
+	assert.Equal(t, 0.8, NameSimilarity("maria", "mario"))
+}
+
+func TestFindDuplicates(t *testing.T) {
+	joao := newTestClient("João da Silva", "11", "987654321")
+	joao.Addresses = []addressentity.Address{{AddressCommonAttributes: addressentity.AddressCommonAttributes{Street: "Rua A", Number: "10", Neighborhood: "Centro"}}}
+
+	// same number registered without the mobile nine
+	joaoAgain := newTestClient("Joao da Silva", "11", "87654321")
+
+	// same number on another ddd is another phone
+	carlos := newTestClient("Carlos", "19", "987654321")
+	joaoAgain.Addresses = []addressentity.Address{{AddressCommonAttributes: addressentity.AddressCommonAttributes{Street: "rua a", Number: "10", Neighborhood: "centro"}}}
+
+	maria := newTestClient("Maria Souza", "11", "912345678")
+	maria.Email = "maria@email.com"
+
+	mariaByEmail := newTestClient("Maria Sousa", "11", "955554444")
+	mariaByEmail.Email = "MARIA@email.com "
+
+	pedro := newTestClient("Pedro", "21", "933332222")
+	pedro.Cpf = "123.456.789-09"
+
+	pedroByCpf := newTestClient("Pedro Henrique", "21", "911110000")
+	pedroByCpf.Cpf = "12345678909"
+
+	duplicates := FindDuplicates([]Client{joao, maria, pedro, joaoAgain, mariaByEmail, pedroByCpf, carlos}, DefaultDuplicateScore)
+	assert.Len(t, duplicates, 3)
+
+	assert.Equal(t, joao.ID, duplicates[0].ClientID)
+	assert.Equal(t, joaoAgain.ID, duplicates[0].DuplicateID)
+	assert.Equal(t, 1.0, duplicates[0].Score)
+	assert.Equal(t, []string{MatchPhone, MatchName, MatchAddress}, duplicates[0].Matches)
+
+	assert.Equal(t, mariaByEmail.ID, duplicates[1].DuplicateID)
+	assert.Equal(t, []string{MatchEmail, MatchName}, duplicates[1].Matches)
+
+	assert.Equal(t, pedroByCpf.ID, duplicates[2].DuplicateID)
+	assert.Equal(t, []string{MatchCpf}, duplicates[2].Matches)
+}
+
+func TestNewClientMerge(t *testing.T) {
+	survivor := newTestClient("Maria", "11", "912345678")
+	merged := newTestClient("Maria Souza", "11", "955554444")
+	merged.Email = "maria@email.com"
+
+	_, err := NewClientMerge(&survivor, &survivor)
+	assert.EqualError(t, err, ErrMergeSameClient.Error())
+
+	merge, err := NewClientMerge(&survivor, &merged)
+	assert.Nil(t, err)
+	assert.Equal(t, merged.ID, merge.MergedID)
+	assert.Equal(t, []string{"11955554444"}, merge.MergedPhones)
+
+	survivor.FillFrom(&merged)
+	assert.Equal(t, "maria@email.com", survivor.Email)
+}
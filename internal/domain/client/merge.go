@@ -0,0 +1,65 @@
+package cliententity
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+)
+
+var (
+	ErrMergeSameClient = errors.New("client cannot be merged into itself")
+)
+
+// ClientMerge is the audit record of a client merged into the surviving client.
+type ClientMerge struct {
+	entity.Entity
+	bun.BaseModel `bun:"table:client_merges"`
+	ClientMergeCommonAttributes
+}
+
+type ClientMergeCommonAttributes struct {
+	SurvivorID   uuid.UUID `bun:"column:survivor_id,type:uuid,notnull" json:"survivor_id"`
+	MergedID     uuid.UUID `bun:"column:merged_id,type:uuid,notnull" json:"merged_id"`
+	MergedName   string    `bun:"merged_name" json:"merged_name"`
+	MergedCpf    string    `bun:"merged_cpf" json:"merged_cpf,omitempty"`
+	MergedEmail  string    `bun:"merged_email" json:"merged_email,omitempty"`
+	MergedPhones []string  `bun:"merged_phones,type:jsonb" json:"merged_phones,omitempty"`
+}
+
+func NewClientMerge(survivor *Client, merged *Client) (*ClientMerge, error) {
+	if survivor.ID == merged.ID {
+		return nil, ErrMergeSameClient
+	}
+
+	phones := []string{}
+	for _, contact := range merged.Contacts {
+		phones = append(phones, contact.Ddd+contact.Number)
+	}
+
+	return &ClientMerge{
+		Entity: entity.NewEntity(),
+		ClientMergeCommonAttributes: ClientMergeCommonAttributes{
+			SurvivorID:   survivor.ID,
+			MergedID:     merged.ID,
+			MergedName:   merged.Name,
+			MergedCpf:    merged.Cpf,
+			MergedEmail:  merged.Email,
+			MergedPhones: phones,
+		},
+	}, nil
+}
+
+// FillFrom copies the personal data missing on the surviving client from the merged client.
+func (c *Client) FillFrom(merged *Client) {
+	if c.Cpf == "" {
+		c.Cpf = merged.Cpf
+	}
+	if c.Email == "" {
+		c.Email = merged.Email
+	}
+	if c.Birthday == nil {
+		c.Birthday = merged.Birthday
+	}
+}
@@ -10,4 +10,8 @@ type Repository interface {
 	DeleteClient(ctx context.Context, id string) error
 	GetClientById(ctx context.Context, id string) (*Client, error)
 	GetAllClients(ctx context.Context) ([]Client, error)
+	GetAllClientStats(ctx context.Context) ([]ClientStats, error)
+	SetDefaultAddress(ctx context.Context, clientID string, addressID string) error
+	MergeClients(ctx context.Context, survivor *Client, merges []ClientMerge) error
+	GetAllClientMerges(ctx context.Context) ([]ClientMerge, error)
 }
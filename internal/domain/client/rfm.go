@@ -0,0 +1,141 @@
+package cliententity
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrSegmentInvalid = errors.New("segment is invalid")
+)
+
+type Segment string
+
+const (
+	SegmentChampions   Segment = "champions"
+	SegmentLoyal       Segment = "loyal"
+	SegmentNew         Segment = "new"
+	SegmentPotential   Segment = "potential"
+	SegmentAtRisk      Segment = "at_risk"
+	SegmentLost        Segment = "lost"
+	SegmentHibernating Segment = "hibernating"
+)
+
+func GetAllSegments() []Segment {
+	return []Segment{SegmentChampions, SegmentLoyal, SegmentNew, SegmentPotential, SegmentAtRisk, SegmentLost, SegmentHibernating}
+}
+
+func ValidateSegment(segment Segment) error {
+	for _, s := range GetAllSegments() {
+		if s == segment {
+			return nil
+		}
+	}
+
+	return ErrSegmentInvalid
+}
+
+// ClientStats sums the completed orders of a client, canceled orders are not counted.
+type ClientStats struct {
+	ClientID     uuid.UUID  `bun:"client_id,type:uuid" json:"client_id"`
+	OrderCount   int        `bun:"order_count" json:"order_count"`
+	TotalSpent   float64    `bun:"total_spent" json:"total_spent"`
+	FirstOrderAt *time.Time `bun:"first_order_at" json:"first_order_at,omitempty"`
+	LastOrderAt  *time.Time `bun:"last_order_at" json:"last_order_at,omitempty"`
+}
+
+func (s *ClientStats) AverageTicket() float64 {
+	if s.OrderCount == 0 {
+		return 0
+	}
+
+	return math.Round(s.TotalSpent/float64(s.OrderCount)*100) / 100
+}
+
+// RFMScore scores from 1 to 5 the recency, frequency and monetary value of the client compared to the client base.
+type RFMScore struct {
+	ClientStats
+	RecencyDays int     `json:"recency_days"`
+	Recency     int     `json:"recency"`
+	Frequency   int     `json:"frequency"`
+	Monetary    int     `json:"monetary"`
+	Segment     Segment `json:"segment"`
+}
+
+// ScoreRFM ranks the clients by quintiles, clients without completed orders are ignored.
+func ScoreRFM(stats []ClientStats, now time.Time) []RFMScore {
+	scores := []RFMScore{}
+
+	for _, s := range stats {
+		if s.OrderCount == 0 || s.LastOrderAt == nil {
+			continue
+		}
+
+		scores = append(scores, RFMScore{
+			ClientStats: s,
+			RecencyDays: int(now.Sub(*s.LastOrderAt).Hours() / 24),
+		})
+	}
+
+	recencies := make([]float64, len(scores))
+	frequencies := make([]float64, len(scores))
+	monetaries := make([]float64, len(scores))
+
+	for i := range scores {
+		// fewer days since the last order is better
+		recencies[i] = -float64(scores[i].RecencyDays)
+		frequencies[i] = float64(scores[i].OrderCount)
+		monetaries[i] = scores[i].TotalSpent
+	}
+
+	recencyScores := quintiles(recencies)
+	frequencyScores := quintiles(frequencies)
+	monetaryScores := quintiles(monetaries)
+
+	for i := range scores {
+		scores[i].Recency = recencyScores[i]
+		scores[i].Frequency = frequencyScores[i]
+		scores[i].Monetary = monetaryScores[i]
+		scores[i].Segment = segmentOf(&scores[i])
+	}
+
+	return scores
+}
+
+// quintiles returns the score from 1 to 5 of each value, equal values have the same score.
+func quintiles(values []float64) []int {
+	sorted := make([]float64, len(values))
+	copy(sorted, values)
+	sort.Float64s(sorted)
+
+	scores := make([]int, len(values))
+	for i, value := range values {
+		rank := sort.SearchFloat64s(sorted, value)
+		scores[i] = 1 + rank*5/len(values)
+	}
+
+	return scores
+}
+
+func segmentOf(score *RFMScore) Segment {
+	switch {
+	case score.Recency >= 4 && score.Frequency >= 4 && score.Monetary >= 4:
+		return SegmentChampions
+	case score.Recency >= 3 && score.Frequency >= 4:
+		return SegmentLoyal
+	case score.Recency >= 4 && score.OrderCount == 1:
+		return SegmentNew
+	case score.Recency >= 3:
+		return SegmentPotential
+	case score.Recency <= 2 && score.Frequency >= 3:
+		return SegmentAtRisk
+	case score.Recency == 1:
+		return SegmentLost
+	default:
+		return SegmentHibernating
+	}
+}
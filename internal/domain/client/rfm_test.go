@@ -0,0 +1,44 @@
+package cliententity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScoreRFM(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	daysAgo := func(days int) *time.Time {
+		date := now.AddDate(0, 0, -days)
+		return &date
+	}
+
+	champion := ClientStats{ClientID: uuid.New(), OrderCount: 20, TotalSpent: 2000, LastOrderAt: daysAgo(1)}
+	newcomer := ClientStats{ClientID: uuid.New(), OrderCount: 1, TotalSpent: 50, LastOrderAt: daysAgo(2)}
+	atRisk := ClientStats{ClientID: uuid.New(), OrderCount: 15, TotalSpent: 1500, LastOrderAt: daysAgo(90)}
+	lost := ClientStats{ClientID: uuid.New(), OrderCount: 1, TotalSpent: 30, LastOrderAt: daysAgo(300)}
+	potential := ClientStats{ClientID: uuid.New(), OrderCount: 2, TotalSpent: 80, LastOrderAt: daysAgo(60)}
+	inactive := ClientStats{ClientID: uuid.New()}
+
+	scores := ScoreRFM([]ClientStats{champion, newcomer, atRisk, lost, potential, inactive}, now)
+	assert.Len(t, scores, 5)
+
+	assert.Equal(t, 1, scores[0].RecencyDays)
+	assert.Equal(t, 5, scores[0].Recency)
+	assert.Equal(t, SegmentChampions, scores[0].Segment)
+	assert.Equal(t, SegmentNew, scores[1].Segment)
+	assert.Equal(t, SegmentAtRisk, scores[2].Segment)
+	assert.Equal(t, SegmentLost, scores[3].Segment)
+	assert.Equal(t, SegmentPotential, scores[4].Segment)
+}
+
+func TestQuintilesTies(t *testing.T) {
+	assert.Equal(t, []int{1, 1, 1, 4, 5}, quintiles([]float64{3, 3, 3, 7, 9}))
+}
+
+func TestAverageTicket(t *testing.T) {
+	assert.Equal(t, 0.0, (&ClientStats{}).AverageTicket())
+	assert.Equal(t, 33.33, (&ClientStats{OrderCount: 3, TotalSpent: 100}).AverageTicket())
+}
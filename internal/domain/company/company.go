@@ -2,6 +2,7 @@ package companyentity
 
 import (
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/teris-io/shortid"
@@ -19,14 +20,18 @@ type Company struct {
 
 type CompanyCommonAttributes struct {
 	SchemaName   string                 `bun:"schema_name,notnull" json:"schema_name"`
+	Slug         string                 `bun:"slug,unique" json:"slug"`
 	BusinessName string                 `bun:"business_name,notnull" json:"business_name"`
 	TradeName    string                 `bun:"trade_name,notnull" json:"trade_name"`
 	Cnpj         string                 `bun:"cnpj,notnull" json:"cnpj"`
 	Email        string                 `bun:"email" json:"email"`
 	Contacts     []string               `bun:"contacts,type:jsonb" json:"contacts,omitempty"`
+	Timezone     string                 `bun:"timezone" json:"timezone"`
 	Address      *addressentity.Address `bun:"rel:has-one,join:id=object_id,notnull" json:"address,omitempty"`
 }
 
+const DefaultTimezone = "America/Sao_Paulo"
+
 type CompanyWithUsers struct {
 	entity.Entity
 	bun.BaseModel `bun:"table:companies"`
@@ -53,6 +58,7 @@ func NewCompany(cnpjData *cnpj.Cnpj) *Company {
 			TradeName:    cnpjData.TradeName,
 			Cnpj:         cnpjData.Cnpj,
 			SchemaName:   schema,
+			Slug:         NewSlug(cnpjData.TradeName, strings.ToLower(id)),
 		},
 	}
 
@@ -70,7 +76,54 @@ func NewCompany(cnpjData *cnpj.Cnpj) *Company {
 
 }
 
+var slugAccents = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ã", "a", "ä", "a",
+	"é", "e", "è", "e", "ê", "e", "ë", "e",
+	"í", "i", "ì", "i", "î", "i", "ï", "i",
+	"ó", "o", "ò", "o", "ô", "o", "õ", "o", "ö", "o",
+	"ú", "u", "ù", "u", "û", "u", "ü", "u",
+	"ç", "c", "ñ", "n",
+)
+
+// NewSlug builds the public identifier of the company used on unauthenticated routes, like the menu.
+func NewSlug(name string, suffix string) string {
+	name = slugAccents.Replace(strings.ToLower(name))
+	slug := strings.Builder{}
+
+	for _, r := range name {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			slug.WriteRune(r)
+		} else if slug.Len() != 0 && !strings.HasSuffix(slug.String(), "-") {
+			slug.WriteRune('-')
+		}
+	}
+
+	if suffix == "" {
+		return strings.TrimSuffix(slug.String(), "-")
+	}
+
+	return strings.TrimSuffix(slug.String(), "-") + "-" + suffix
+}
+
 func (c *Company) AddAddress(addressCommonAttributes *addressentity.AddressCommonAttributes) {
 	addressCommonAttributes.ObjectID = c.ID
 	c.Address = addressentity.NewAddress(addressCommonAttributes)
 }
+
+// Location returns the company timezone, schedules are evaluated on it.
+func (c *Company) Location() *time.Location {
+	timezone := c.Timezone
+	if timezone == "" {
+		timezone = DefaultTimezone
+	}
+
+	if location, err := time.LoadLocation(timezone); err == nil {
+		return location
+	}
+
+	return time.FixedZone("BRT", -3*60*60)
+}
+
+func (c *Company) Now() time.Time {
+	return time.Now().In(c.Location())
+}
@@ -0,0 +1,12 @@
+package companyentity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSlug(t *testing.T) {
+	assert.Equal(t, "pizzaria-sao-joao-ab12", NewSlug("Pizzaria São João", "ab12"))
+	assert.Equal(t, "bar-do-ze", NewSlug("  Bar do Zé!! ", ""))
+}
@@ -9,6 +9,7 @@ import (
 type CompanyRepository interface {
 	NewCompany(ctx context.Context, company *Company) error
 	GetCompany(ctx context.Context) (*Company, error)
+	GetCompanyBySlug(ctx context.Context, slug string) (*Company, error)
 	ValidateUserToPublicCompany(ctx context.Context, userID uuid.UUID) (bool, error)
 	AddUserToPublicCompany(ctx context.Context, userID uuid.UUID) error
 	RemoveUserFromPublicCompany(ctx context.Context, userID uuid.UUID) error
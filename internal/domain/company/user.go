@@ -1,6 +1,9 @@
 package companyentity
 
 import (
+	"context"
+
+	"github.com/google/uuid"
 	"github.com/uptrace/bun"
 	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
 )
@@ -36,3 +39,13 @@ func (u *User) GetSchemas() []string {
 
 	return schemas
 }
+
+// GetUserIDFromContext returns the id of the logged user, nil on jobs and public routes.
+func GetUserIDFromContext(ctx context.Context) *uuid.UUID {
+	user, ok := ctx.Value(UserValue("user")).(User)
+	if !ok {
+		return nil
+	}
+
+	return &user.ID
+}
@@ -0,0 +1,290 @@
+package deliveryzoneentity
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	addressentity "github.com/willjrcom/sales-backend-go/internal/domain/address"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+)
+
+var (
+	ErrNameRequired          = errors.New("name is required")
+	ErrZoneTypeInvalid       = errors.New("zone type is invalid")
+	ErrNeighborhoodsRequired = errors.New("neighborhoods are required")
+	ErrCepRangeInvalid       = errors.New("cep range is invalid")
+	ErrRadiusInvalid         = errors.New("radius must be greater than zero")
+	ErrPolygonInvalid        = errors.New("polygon must have at least 3 points")
+	ErrFeeInvalid            = errors.New("fee must not be negative")
+	ErrMinOrderValueInvalid  = errors.New("min order value must not be negative")
+	ErrAddressOutsideZones   = errors.New("address is outside every delivery zone")
+	ErrStoreLocationRequired = errors.New("store address must have latitude and longitude")
+)
+
+const earthRadiusKm = 6371.0
+
+type ZoneType string
+
+const (
+	ZoneTypeNeighborhood ZoneType = "neighborhood"
+	ZoneTypeCepRange     ZoneType = "cep_range"
+	ZoneTypeRadius       ZoneType = "radius"
+	ZoneTypePolygon      ZoneType = "polygon"
+)
+
+func GetAllZoneTypes() []ZoneType {
+	return []ZoneType{ZoneTypeNeighborhood, ZoneTypeCepRange, ZoneTypeRadius, ZoneTypePolygon}
+}
+
+var neighborhoodAccents = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ã", "a",
+	"é", "e", "ê", "e",
+	"í", "i",
+	"ó", "o", "ô", "o", "õ", "o",
+	"ú", "u", "ü", "u",
+	"ç", "c",
+)
+
+// DeliveryZone is an area where the store delivers, with the fee charged on the deliveries of the area.
+type DeliveryZone struct {
+	entity.Entity
+	bun.BaseModel `bun:"table:delivery_zones"`
+	DeliveryZoneCommonAttributes
+}
+
+type DeliveryZoneCommonAttributes struct {
+	Name          string        `bun:"name,notnull" json:"name"`
+	Type          ZoneType      `bun:"type,notnull" json:"type"`
+	City          string        `bun:"city" json:"city,omitempty"`
+	Neighborhoods []string      `bun:"neighborhoods,type:jsonb" json:"neighborhoods,omitempty"`
+	CepRanges     []CepRange    `bun:"cep_ranges,type:jsonb" json:"cep_ranges,omitempty"`
+	RadiusKm      float64       `bun:"radius_km" json:"radius_km,omitempty"`
+	Polygon       []Point       `bun:"polygon,type:jsonb" json:"polygon,omitempty"`
+	Fee           float64       `bun:"fee,notnull" json:"fee"`
+	MinOrderValue float64       `bun:"min_order_value" json:"min_order_value"`
+	EstimatedTime time.Duration `bun:"estimated_time" json:"estimated_time"`
+	IsActive      bool          `bun:"is_active" json:"is_active"`
+}
+
+type PatchDeliveryZone struct {
+	Name          *string        `json:"name"`
+	Type          *ZoneType      `json:"type"`
+	City          *string        `json:"city"`
+	Neighborhoods []string       `json:"neighborhoods"`
+	CepRanges     []CepRange     `json:"cep_ranges"`
+	RadiusKm      *float64       `json:"radius_km"`
+	Polygon       []Point        `json:"polygon"`
+	Fee           *float64       `json:"fee"`
+	MinOrderValue *float64       `json:"min_order_value"`
+	EstimatedTime *time.Duration `json:"estimated_time"`
+	IsActive      *bool          `json:"is_active"`
+}
+
+// CepRange includes the ceps from start to end, both with 8 digits.
+type CepRange struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+type Point struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// DeliveryQuote is the fee of a delivery to an address, the zone is empty when the store has no zones.
+type DeliveryQuote struct {
+	DeliveryZoneID *uuid.UUID    `json:"delivery_zone_id,omitempty"`
+	Fee            float64       `json:"fee"`
+	MinOrderValue  float64       `json:"min_order_value"`
+	EstimatedTime  time.Duration `json:"estimated_time"`
+}
+
+func NewDeliveryZone(attributes DeliveryZoneCommonAttributes) *DeliveryZone {
+	return &DeliveryZone{
+		Entity:                       entity.NewEntity(),
+		DeliveryZoneCommonAttributes: attributes,
+	}
+}
+
+func (z *DeliveryZone) Validate() error {
+	if z.Name == "" {
+		return ErrNameRequired
+	}
+
+	if z.Fee < 0 {
+		return ErrFeeInvalid
+	}
+
+	if z.MinOrderValue < 0 {
+		return ErrMinOrderValueInvalid
+	}
+
+	switch z.Type {
+	case ZoneTypeNeighborhood:
+		if len(z.Neighborhoods) == 0 {
+			return ErrNeighborhoodsRequired
+		}
+	case ZoneTypeCepRange:
+		if len(z.CepRanges) == 0 {
+			return ErrCepRangeInvalid
+		}
+
+		for _, cepRange := range z.CepRanges {
+			start, end := normalizeCep(cepRange.Start), normalizeCep(cepRange.End)
+			if len(start) != 8 || len(end) != 8 || start > end {
+				return ErrCepRangeInvalid
+			}
+		}
+	case ZoneTypeRadius:
+		if z.RadiusKm <= 0 {
+			return ErrRadiusInvalid
+		}
+	case ZoneTypePolygon:
+		if len(z.Polygon) < 3 {
+			return ErrPolygonInvalid
+		}
+	default:
+		return ErrZoneTypeInvalid
+	}
+
+	return nil
+}
+
+// Contains is true when the address is inside the zone, radius zones are around the store.
+func (z *DeliveryZone) Contains(address *addressentity.Address, store *Point) bool {
+	if z.City != "" && normalizeName(z.City) != normalizeName(address.City) {
+		return false
+	}
+
+	switch z.Type {
+	case ZoneTypeNeighborhood:
+		neighborhood := normalizeName(address.Neighborhood)
+		for _, n := range z.Neighborhoods {
+			if normalizeName(n) == neighborhood {
+				return true
+			}
+		}
+	case ZoneTypeCepRange:
+		cep := normalizeCep(address.Cep)
+		if len(cep) != 8 {
+			return false
+		}
+
+		for _, cepRange := range z.CepRanges {
+			if cep >= normalizeCep(cepRange.Start) && cep <= normalizeCep(cepRange.End) {
+				return true
+			}
+		}
+	case ZoneTypeRadius:
+		point := PointOf(address)
+		return point != nil && store != nil && DistanceKm(*store, *point) <= z.RadiusKm
+	case ZoneTypePolygon:
+		point := PointOf(address)
+		return point != nil && insidePolygon(*point, z.Polygon)
+	}
+
+	return false
+}
+
+func (z *DeliveryZone) Quote() *DeliveryQuote {
+	return &DeliveryQuote{
+		DeliveryZoneID: &z.ID,
+		Fee:            z.Fee,
+		MinOrderValue:  z.MinOrderValue,
+		EstimatedTime:  z.EstimatedTime,
+	}
+}
+
+// FindZone returns the active zone with the lowest fee containing the address.
+// Radius zones are skipped without the store location, ErrStoreLocationRequired when no other zone matches.
+func FindZone(zones []DeliveryZone, address *addressentity.Address, store *Point) (*DeliveryZone, error) {
+	var found *DeliveryZone
+	skippedRadius := false
+
+	for i := range zones {
+		zone := &zones[i]
+		if zone.IsActive && zone.Type == ZoneTypeRadius && store == nil {
+			skippedRadius = true
+		}
+
+		if !zone.IsActive || !zone.Contains(address, store) {
+			continue
+		}
+
+		if found == nil || zone.Fee < found.Fee {
+			found = zone
+		}
+	}
+
+	if found == nil && skippedRadius {
+		return nil, ErrStoreLocationRequired
+	}
+
+	if found == nil {
+		return nil, ErrAddressOutsideZones
+	}
+
+	return found, nil
+}
+
+// RequiresStoreLocation reports whether the zone is measured from the store address.
+func (z *DeliveryZone) RequiresStoreLocation() bool {
+	return z.IsActive && z.Type == ZoneTypeRadius
+}
+
+// PointOf returns the coordinates of the address, nil when the address has no coordinates.
+func PointOf(address *addressentity.Address) *Point {
+	if address == nil || address.Latitude == nil || address.Longitude == nil {
+		return nil
+	}
+
+	return &Point{Latitude: *address.Latitude, Longitude: *address.Longitude}
+}
+
+// DistanceKm returns the haversine distance between the points.
+func DistanceKm(a, b Point) float64 {
+	lat1, lat2 := toRadians(a.Latitude), toRadians(b.Latitude)
+	deltaLat := lat2 - lat1
+	deltaLng := toRadians(b.Longitude - a.Longitude)
+
+	h := math.Sin(deltaLat/2)*math.Sin(deltaLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(deltaLng/2)*math.Sin(deltaLng/2)
+	return 2 * earthRadiusKm * math.Asin(math.Sqrt(h))
+}
+
+// insidePolygon uses ray casting, counting the edges crossed by a ray going east from the point.
+func insidePolygon(point Point, polygon []Point) bool {
+	inside := false
+
+	for i, j := 0, len(polygon)-1; i < len(polygon); j, i = i, i+1 {
+		a, b := polygon[i], polygon[j]
+
+		if (a.Latitude > point.Latitude) != (b.Latitude > point.Latitude) &&
+			point.Longitude < (b.Longitude-a.Longitude)*(point.Latitude-a.Latitude)/(b.Latitude-a.Latitude)+a.Longitude {
+			inside = !inside
+		}
+	}
+
+	return inside
+}
+
+func toRadians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
+
+func normalizeName(name string) string {
+	return strings.Join(strings.Fields(neighborhoodAccents.Replace(strings.ToLower(name))), " ")
+}
+
+func normalizeCep(cep string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsDigit(r) {
+			return r
+		}
+		return -1
+	}, cep)
+}
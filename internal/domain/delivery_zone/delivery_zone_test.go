@@ -0,0 +1,96 @@
+package deliveryzoneentity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	addressentity "github.com/willjrcom/sales-backend-go/internal/domain/address"
+)
+
+func newAddress(neighborhood, cep string, latitude, longitude float64) *addressentity.Address {
+	return &addressentity.Address{AddressCommonAttributes: addressentity.AddressCommonAttributes{
+		Neighborhood: neighborhood,
+		City:         "São Paulo",
+		Cep:          cep,
+		Latitude:     &latitude,
+		Longitude:    &longitude,
+	}}
+}
+
+func TestContains(t *testing.T) {
+	store := &Point{Latitude: -23.5505, Longitude: -46.6333}
+	address := newAddress("Consolação", "01301-000", -23.5558, -46.6620)
+
+	neighborhood := NewDeliveryZone(DeliveryZoneCommonAttributes{Type: ZoneTypeNeighborhood, City: "sao paulo", Neighborhoods: []string{"Bela Vista", "consolacao"}})
+	assert.True(t, neighborhood.Contains(address, store))
+
+	neighborhood.City = "Campinas"
+	assert.False(t, neighborhood.Contains(address, store))
+
+	cepRange := NewDeliveryZone(DeliveryZoneCommonAttributes{Type: ZoneTypeCepRange, CepRanges: []CepRange{{Start: "01300-000", End: "01399-999"}}})
+	assert.True(t, cepRange.Contains(address, store))
+	assert.False(t, cepRange.Contains(newAddress("Centro", "02000-000", 0, 0), store))
+
+	radius := NewDeliveryZone(DeliveryZoneCommonAttributes{Type: ZoneTypeRadius, RadiusKm: 5})
+	assert.True(t, radius.Contains(address, store))
+	assert.False(t, radius.Contains(address, nil))
+	assert.False(t, radius.Contains(newAddress("Centro", "", -23.0, -46.0), store))
+
+	polygon := NewDeliveryZone(DeliveryZoneCommonAttributes{Type: ZoneTypePolygon, Polygon: []Point{
+		{Latitude: -23.50, Longitude: -46.70},
+		{Latitude: -23.50, Longitude: -46.60},
+		{Latitude: -23.60, Longitude: -46.60},
+		{Latitude: -23.60, Longitude: -46.70},
+	}})
+	assert.True(t, polygon.Contains(address, store))
+	assert.False(t, polygon.Contains(newAddress("Centro", "", -23.45, -46.65), store))
+}
+
+func TestFindZone(t *testing.T) {
+	address := newAddress("Centro", "01001-000", -23.5505, -46.6333)
+
+	expensive := *NewDeliveryZone(DeliveryZoneCommonAttributes{Name: "Cidade", Type: ZoneTypeCepRange, CepRanges: []CepRange{{Start: "01000000", End: "09999999"}}, Fee: 12, IsActive: true})
+	cheap := *NewDeliveryZone(DeliveryZoneCommonAttributes{Name: "Centro", Type: ZoneTypeNeighborhood, Neighborhoods: []string{"Centro"}, Fee: 5, MinOrderValue: 30, IsActive: true})
+	inactive := *NewDeliveryZone(DeliveryZoneCommonAttributes{Name: "Grátis", Type: ZoneTypeNeighborhood, Neighborhoods: []string{"Centro"}, Fee: 0})
+
+	zone, err := FindZone([]DeliveryZone{expensive, cheap, inactive}, address, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, cheap.ID, zone.ID)
+	assert.Equal(t, 30.0, zone.Quote().MinOrderValue)
+
+	_, err = FindZone([]DeliveryZone{expensive, cheap}, newAddress("Moema", "04000-000", 0, 0), nil)
+	assert.Nil(t, err)
+
+	_, err = FindZone([]DeliveryZone{cheap, inactive}, newAddress("Moema", "04000-000", 0, 0), nil)
+	assert.EqualError(t, err, ErrAddressOutsideZones.Error())
+
+	radius := *NewDeliveryZone(DeliveryZoneCommonAttributes{Name: "Raio", Type: ZoneTypeRadius, RadiusKm: 10, Fee: 8, IsActive: true})
+	_, err = FindZone([]DeliveryZone{cheap, radius}, newAddress("Moema", "04000-000", -23.60, -46.66), nil)
+	assert.EqualError(t, err, ErrStoreLocationRequired.Error())
+
+	zone, err = FindZone([]DeliveryZone{cheap, radius}, newAddress("Moema", "04000-000", -23.60, -46.66), &Point{Latitude: -23.5505, Longitude: -46.6333})
+	assert.Nil(t, err)
+	assert.Equal(t, radius.ID, zone.ID)
+}
+
+func TestValidate(t *testing.T) {
+	zone := NewDeliveryZone(DeliveryZoneCommonAttributes{Name: "Zona", Type: ZoneTypeCepRange, CepRanges: []CepRange{{Start: "09999-999", End: "01000-000"}}})
+	assert.EqualError(t, zone.Validate(), ErrCepRangeInvalid.Error())
+
+	zone.Type = ZoneTypePolygon
+	assert.EqualError(t, zone.Validate(), ErrPolygonInvalid.Error())
+
+	zone.Type = "city"
+	assert.EqualError(t, zone.Validate(), ErrZoneTypeInvalid.Error())
+
+	zone.Type = ZoneTypeRadius
+	zone.RadiusKm = 3
+	assert.Nil(t, zone.Validate())
+}
+
+func TestDistanceKm(t *testing.T) {
+	saoPaulo := Point{Latitude: -23.5505, Longitude: -46.6333}
+	rio := Point{Latitude: -22.9068, Longitude: -43.1729}
+
+	assert.InDelta(t, 357, DistanceKm(saoPaulo, rio), 5)
+}
@@ -0,0 +1,11 @@
+package deliveryzoneentity
+
+import "context"
+
+type Repository interface {
+	RegisterDeliveryZone(ctx context.Context, zone *DeliveryZone) error
+	UpdateDeliveryZone(ctx context.Context, zone *DeliveryZone) error
+	DeleteDeliveryZone(ctx context.Context, id string) error
+	GetDeliveryZoneById(ctx context.Context, id string) (*DeliveryZone, error)
+	GetAllDeliveryZones(ctx context.Context) ([]DeliveryZone, error)
+}
@@ -0,0 +1,234 @@
+package driversettlemententity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+)
+
+var (
+	ErrDriverRequired         = errors.New("driver is required")
+	ErrPeriodRequired         = errors.New("shift or start and end dates are required")
+	ErrPeriodInvalid          = errors.New("start must be before end")
+	ErrPayTypeInvalid         = errors.New("pay type is invalid")
+	ErrPayRateInvalid         = errors.New("pay rate must not be negative")
+	ErrFeeShareInvalid        = errors.New("fee share must be between 0 and 1")
+	ErrSettlementClosed       = errors.New("settlement already closed")
+	ErrDeliveryAlreadySettled = errors.New("delivery already settled")
+)
+
+type PayType string
+
+const (
+	PayTypePerDelivery PayType = "per_delivery"
+	PayTypeFeeShare    PayType = "fee_share"
+)
+
+type StatusSettlement string
+
+const (
+	SettlementStatusOpen    StatusSettlement = "Open"
+	SettlementStatusSettled StatusSettlement = "Settled"
+)
+
+// DriverSettlement is what the store owes the driver for the deliveries of a period,
+// and what the driver owes the store for the cash collected on them.
+type DriverSettlement struct {
+	entity.Entity
+	bun.BaseModel `bun:"table:driver_settlements"`
+	DriverSettlementCommonAttributes
+}
+
+type DriverSettlementCommonAttributes struct {
+	SettlementPeriod
+	PayType    PayType              `bun:"pay_type,notnull" json:"pay_type"`
+	PayRate    float64              `bun:"pay_rate" json:"pay_rate"`
+	Status     StatusSettlement     `bun:"status,notnull" json:"status"`
+	Deliveries []SettlementDelivery `bun:"deliveries,type:jsonb" json:"deliveries"`
+	SettlementTotals
+	SettledAt *time.Time `bun:"settled_at" json:"settled_at,omitempty"`
+}
+
+// SettlementPeriod is a shift of the driver, or a date range when there is no shift.
+type SettlementPeriod struct {
+	DriverID uuid.UUID  `bun:"column:driver_id,type:uuid,notnull" json:"driver_id"`
+	ShiftID  *uuid.UUID `bun:"column:shift_id,type:uuid" json:"shift_id,omitempty"`
+	StartAt  *time.Time `bun:"start_at" json:"start_at,omitempty"`
+	EndAt    *time.Time `bun:"end_at" json:"end_at,omitempty"`
+}
+
+type SettlementDelivery struct {
+	DeliveryID  uuid.UUID                         `json:"delivery_id"`
+	OrderID     uuid.UUID                         `json:"order_id"`
+	LaunchedAt  *time.Time                        `json:"launched_at,omitempty"`
+	DeliveredAt *time.Time                        `json:"delivered_at,omitempty"`
+	Duration    time.Duration                     `json:"duration"`
+	DeliveryTax float64                           `json:"delivery_tax"`
+	DriverPay   float64                           `json:"driver_pay"`
+	Collected   map[orderentity.PayMethod]float64 `json:"collected,omitempty"`
+}
+
+// SettlementTotals sums the deliveries, the balance is positive when the store pays the driver
+// and negative when the driver hands money to the store.
+type SettlementTotals struct {
+	TotalDeliveries   int                               `bun:"total_deliveries" json:"total_deliveries"`
+	DeliveryFees      float64                           `bun:"delivery_fees" json:"delivery_fees"`
+	AverageDuration   time.Duration                     `bun:"average_duration" json:"average_duration"`
+	CollectedByMethod map[orderentity.PayMethod]float64 `bun:"collected_by_method,type:jsonb" json:"collected_by_method"`
+	CashCollected     float64                           `bun:"cash_collected" json:"cash_collected"`
+	CardCollected     float64                           `bun:"card_collected" json:"card_collected"`
+	OtherCollected    float64                           `bun:"other_collected" json:"other_collected"`
+	OwedToDriver      float64                           `bun:"owed_to_driver" json:"owed_to_driver"`
+	OwedByDriver      float64                           `bun:"owed_by_driver" json:"owed_by_driver"`
+	Balance           float64                           `bun:"balance" json:"balance"`
+}
+
+func NewDriverSettlement(period SettlementPeriod, payType PayType, payRate float64) *DriverSettlement {
+	return &DriverSettlement{
+		Entity: entity.NewEntity(),
+		DriverSettlementCommonAttributes: DriverSettlementCommonAttributes{
+			SettlementPeriod: period,
+			PayType:          payType,
+			PayRate:          payRate,
+			Status:           SettlementStatusOpen,
+		},
+	}
+}
+
+func (s *DriverSettlement) Validate() error {
+	if s.DriverID == uuid.Nil {
+		return ErrDriverRequired
+	}
+
+	if s.ShiftID == nil && (s.StartAt == nil || s.EndAt == nil) {
+		return ErrPeriodRequired
+	}
+
+	if s.StartAt != nil && s.EndAt != nil && !s.StartAt.Before(*s.EndAt) {
+		return ErrPeriodInvalid
+	}
+
+	if s.PayRate < 0 {
+		return ErrPayRateInvalid
+	}
+
+	switch s.PayType {
+	case PayTypePerDelivery:
+	case PayTypeFeeShare:
+		if s.PayRate > 1 {
+			return ErrFeeShareInvalid
+		}
+	default:
+		return ErrPayTypeInvalid
+	}
+
+	return nil
+}
+
+// Calculate rebuilds the lines and the totals from the delivered orders of the driver
+// and the payments the driver collected on them.
+func (s *DriverSettlement) Calculate(deliveries []orderentity.DeliveryOrder, payments []orderentity.PaymentOrder) error {
+	if s.Status == SettlementStatusSettled {
+		return ErrSettlementClosed
+	}
+
+	collected := map[uuid.UUID]map[orderentity.PayMethod]float64{}
+	for _, payment := range payments {
+		if payment.RefundedAt != nil || payment.DriverID == nil || *payment.DriverID != s.DriverID {
+			continue
+		}
+
+		if collected[payment.OrderID] == nil {
+			collected[payment.OrderID] = map[orderentity.PayMethod]float64{}
+		}
+
+		collected[payment.OrderID][payment.Method] += payment.TotalPaid
+	}
+
+	s.Deliveries = []SettlementDelivery{}
+	s.SettlementTotals = SettlementTotals{CollectedByMethod: map[orderentity.PayMethod]float64{}}
+
+	totalDuration := time.Duration(0)
+
+	for _, delivery := range deliveries {
+		line := SettlementDelivery{
+			DeliveryID:  delivery.ID,
+			OrderID:     delivery.OrderID,
+			LaunchedAt:  delivery.LaunchedAt,
+			DeliveredAt: delivery.DeliveredAt,
+			Collected:   collected[delivery.OrderID],
+		}
+
+		if delivery.DeliveryTax != nil {
+			line.DeliveryTax = *delivery.DeliveryTax
+		}
+
+		if delivery.LaunchedAt != nil && delivery.DeliveredAt != nil {
+			line.Duration = delivery.DeliveredAt.Sub(*delivery.LaunchedAt)
+		}
+
+		line.DriverPay = s.driverPay(line.DeliveryTax)
+
+		for method, value := range line.Collected {
+			s.CollectedByMethod[method] += value
+
+			switch method.Kind() {
+			case orderentity.PayMethodKindCash:
+				s.CashCollected += value
+			case orderentity.PayMethodKindCard, orderentity.PayMethodKindVoucher:
+				s.CardCollected += value
+			default:
+				s.OtherCollected += value
+			}
+		}
+
+		totalDuration += line.Duration
+		s.DeliveryFees += line.DeliveryTax
+		s.OwedToDriver += line.DriverPay
+		s.Deliveries = append(s.Deliveries, line)
+	}
+
+	s.TotalDeliveries = len(s.Deliveries)
+	if s.TotalDeliveries > 0 {
+		s.AverageDuration = totalDuration / time.Duration(s.TotalDeliveries)
+	}
+
+	// card and voucher payments go to the store account on the card machine, only the cash stays with the driver,
+	// the other methods are checked by the store and kept out of the balance
+	s.OwedByDriver = s.CashCollected
+	s.Balance = s.OwedToDriver - s.OwedByDriver
+	return nil
+}
+
+// Close settles the report, it can not be calculated again.
+func (s *DriverSettlement) Close() error {
+	if s.Status == SettlementStatusSettled {
+		return ErrSettlementClosed
+	}
+
+	now := time.Now()
+	s.Status = SettlementStatusSettled
+	s.SettledAt = &now
+	return nil
+}
+
+func (s *DriverSettlement) DeliveryIDs() []uuid.UUID {
+	ids := []uuid.UUID{}
+	for _, delivery := range s.Deliveries {
+		ids = append(ids, delivery.DeliveryID)
+	}
+
+	return ids
+}
+
+func (s *DriverSettlement) driverPay(deliveryTax float64) float64 {
+	if s.PayType == PayTypeFeeShare {
+		return deliveryTax * s.PayRate
+	}
+
+	return s.PayRate
+}
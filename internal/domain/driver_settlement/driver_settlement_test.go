@@ -0,0 +1,84 @@
+package driversettlemententity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+)
+
+func newDelivered(driverID uuid.UUID, tax float64, duration time.Duration) orderentity.DeliveryOrder {
+	delivery := *orderentity.NewDeliveryOrder(uuid.New())
+	delivery.OrderID = uuid.New()
+	delivery.DriverID = &driverID
+	delivery.DeliveryTax = &tax
+
+	launchedAt := time.Date(2024, 5, 1, 19, 0, 0, 0, time.UTC)
+	deliveredAt := launchedAt.Add(duration)
+	delivery.LaunchedAt = &launchedAt
+	delivery.DeliveredAt = &deliveredAt
+	return delivery
+}
+
+func collectedBy(driverID uuid.UUID, orderID uuid.UUID, method orderentity.PayMethod, value float64) orderentity.PaymentOrder {
+	payment := *orderentity.NewPayment(value, method, orderID)
+	payment.DriverID = &driverID
+	return payment
+}
+
+func TestCalculate(t *testing.T) {
+	driverID := uuid.New()
+	first := newDelivered(driverID, 8, 20*time.Minute)
+	second := newDelivered(driverID, 12, 40*time.Minute)
+
+	payments := []orderentity.PaymentOrder{
+		collectedBy(driverID, first.OrderID, orderentity.Dinheiro, 50),
+		collectedBy(driverID, second.OrderID, orderentity.Visa, 70),
+		collectedBy(driverID, second.OrderID, orderentity.Alelo, 15),
+		collectedBy(driverID, first.OrderID, orderentity.Outros, 5),
+		collectedBy(uuid.New(), second.OrderID, orderentity.Dinheiro, 10),
+		*orderentity.NewPayment(30, orderentity.Dinheiro, first.OrderID),
+	}
+
+	settlement := NewDriverSettlement(SettlementPeriod{DriverID: driverID, ShiftID: &driverID}, PayTypeFeeShare, 0.5)
+	assert.Nil(t, settlement.Validate())
+	assert.Nil(t, settlement.Calculate([]orderentity.DeliveryOrder{first, second}, payments))
+
+	assert.Equal(t, 2, settlement.TotalDeliveries)
+	assert.Equal(t, 20.0, settlement.DeliveryFees)
+	assert.Equal(t, 30*time.Minute, settlement.AverageDuration)
+	assert.Equal(t, 50.0, settlement.CashCollected)
+	assert.Equal(t, 85.0, settlement.CardCollected)
+	assert.Equal(t, 5.0, settlement.OtherCollected)
+	assert.Equal(t, 70.0, settlement.CollectedByMethod[orderentity.Visa])
+	assert.Equal(t, 10.0, settlement.OwedToDriver)
+	assert.Equal(t, 50.0, settlement.OwedByDriver)
+	assert.Equal(t, -40.0, settlement.Balance)
+
+	settlement.PayType = PayTypePerDelivery
+	settlement.PayRate = 7
+	assert.Nil(t, settlement.Calculate([]orderentity.DeliveryOrder{first, second}, nil))
+	assert.Equal(t, 14.0, settlement.Balance)
+
+	assert.Nil(t, settlement.Close())
+	assert.EqualError(t, settlement.Close(), ErrSettlementClosed.Error())
+	assert.EqualError(t, settlement.Calculate(nil, nil), ErrSettlementClosed.Error())
+	assert.Len(t, settlement.DeliveryIDs(), 2)
+}
+
+func TestValidateSettlement(t *testing.T) {
+	start := time.Now()
+	end := start.Add(-time.Hour)
+
+	settlement := NewDriverSettlement(SettlementPeriod{DriverID: uuid.New()}, PayTypePerDelivery, 5)
+	assert.EqualError(t, settlement.Validate(), ErrPeriodRequired.Error())
+
+	settlement.StartAt, settlement.EndAt = &start, &end
+	assert.EqualError(t, settlement.Validate(), ErrPeriodInvalid.Error())
+
+	settlement.StartAt, settlement.EndAt = &end, &start
+	settlement.PayType = PayTypeFeeShare
+	assert.EqualError(t, settlement.Validate(), ErrFeeShareInvalid.Error())
+}
@@ -0,0 +1,18 @@
+package driversettlemententity
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+)
+
+type Repository interface {
+	RegisterDriverSettlement(ctx context.Context, settlement *DriverSettlement) error
+	UpdateDriverSettlement(ctx context.Context, settlement *DriverSettlement) error
+	CloseDriverSettlement(ctx context.Context, settlement *DriverSettlement) error
+	GetDriverSettlementById(ctx context.Context, id string) (*DriverSettlement, error)
+	GetAllDriverSettlements(ctx context.Context) ([]DriverSettlement, error)
+	GetDeliveriesToSettle(ctx context.Context, period *SettlementPeriod) ([]orderentity.DeliveryOrder, error)
+	GetPaymentsCollected(ctx context.Context, driverID uuid.UUID, orderIDs []uuid.UUID) ([]orderentity.PaymentOrder, error)
+}
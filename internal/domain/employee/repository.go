@@ -7,5 +7,6 @@ type Repository interface {
 	UpdateEmployee(ctx context.Context, p *Employee) error
 	DeleteEmployee(ctx context.Context, id string) error
 	GetEmployeeById(ctx context.Context, id string) (*Employee, error)
+	GetEmployeeByUserId(ctx context.Context, userID string) (*Employee, error)
 	GetAllEmployees(ctx context.Context) ([]Employee, error)
 }
@@ -2,6 +2,8 @@ package groupitementity
 
 import (
 	"context"
+
+	itementity "github.com/willjrcom/sales-backend-go/internal/domain/item"
 )
 
 type GroupItemRepository interface {
@@ -12,4 +14,5 @@ type GroupItemRepository interface {
 	DeleteGroupItem(ctx context.Context, id string, complementItemID *string) error
 	GetGroupsByOrderIDAndStatus(ctx context.Context, id string, status StatusGroupItem) ([]GroupItem, error)
 	GetGroupsByStatus(ctx context.Context, status StatusGroupItem) ([]GroupItem, error)
+	AddComboItems(ctx context.Context, created []GroupItem, updated []GroupItem, items []itementity.Item) error
 }
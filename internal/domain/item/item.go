@@ -29,11 +29,29 @@ type ItemCommonAttributes struct {
 	Description     string     `bun:"description" json:"description"`
 	Observation     string     `bun:"observation" json:"observation"`
 	Price           float64    `bun:"price,notnull" json:"price"`
+	ProductPrice    float64    `bun:"product_price" json:"product_price"`
 	TotalPrice      float64    `bun:"total_price,notnull" json:"total_price"`
 	Size            string     `bun:"size,notnull" json:"size"`
 	Quantity        float64    `bun:"quantity,notnull" json:"quantity"`
 	GroupItemID     uuid.UUID  `bun:"group_item_id,type:uuid" json:"group_item_id"`
 	AdditionalItems []Item     `bun:"m2m:item_to_additional,join:Item=AdditionalItem" json:"item_to_additional,omitempty"`
+	ItemCombo
+	ItemPriceRule
+}
+
+// ItemCombo links the components of the same combo, each component is an item in its own group.
+type ItemCombo struct {
+	ComboID        *uuid.UUID `bun:"combo_id,type:uuid" json:"combo_id,omitempty"`
+	ComboProductID *uuid.UUID `bun:"combo_product_id,type:uuid" json:"combo_product_id,omitempty"`
+	ComboSlotID    *uuid.UUID `bun:"combo_slot_id,type:uuid" json:"combo_slot_id,omitempty"`
+}
+
+// ItemPriceRule records which price list rule changed the price charged.
+type ItemPriceRule struct {
+	BasePrice   float64    `bun:"base_price" json:"base_price"`
+	PriceListID *uuid.UUID `bun:"price_list_id,type:uuid" json:"price_list_id,omitempty"`
+	PriceRuleID *uuid.UUID `bun:"price_rule_id,type:uuid" json:"price_rule_id,omitempty"`
+	PriceRule   string     `bun:"price_rule" json:"price_rule,omitempty"`
 }
 
 type ItemTimeLogs struct {
@@ -45,12 +63,13 @@ type ItemTimeLogs struct {
 
 func NewItem(name string, price float64, quantity float64, size string, status StatusItem) *Item {
 	itemAdditionalCommonAttributes := ItemCommonAttributes{
-		Name:       name + " (" + size + ")",
-		Status:     status,
-		Price:      price,
-		TotalPrice: price * quantity,
-		Size:       size,
-		Quantity:   quantity,
+		Name:         name + " (" + size + ")",
+		Status:       status,
+		Price:        price,
+		ProductPrice: price,
+		TotalPrice:   price * quantity,
+		Size:         size,
+		Quantity:     quantity,
 	}
 
 	return &Item{
@@ -59,6 +78,19 @@ func NewItem(name string, price float64, quantity float64, size string, status S
 	}
 }
 
+func (i *Item) AddToCombo(comboID uuid.UUID, comboProductID uuid.UUID, comboSlotID uuid.UUID) {
+	i.ComboID = &comboID
+	i.ComboProductID = &comboProductID
+	i.ComboSlotID = &comboSlotID
+}
+
+func (i *Item) AddPriceRule(basePrice float64, priceListID *uuid.UUID, priceRuleID *uuid.UUID, priceRule string) {
+	i.BasePrice = basePrice
+	i.PriceListID = priceListID
+	i.PriceRuleID = priceRuleID
+	i.PriceRule = priceRule
+}
+
 func (i *Item) PendingItem() (err error) {
 	if i.Status != StatusItemStaging {
 		return nil
@@ -0,0 +1,61 @@
+package loyaltyentity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPointsFor(t *testing.T) {
+	program := NewLoyaltyProgram()
+	assert.Equal(t, 0, program.PointsFor(100))
+
+	program.IsActive = true
+	program.PointsPerCurrency = 0.5
+	assert.Equal(t, 24, program.PointsFor(49.90))
+	assert.Equal(t, 0, program.PointsFor(-10))
+}
+
+func TestRewardDiscountOn(t *testing.T) {
+	productID := uuid.New()
+	freeProduct := NewReward(RewardCommonAttributes{Name: "Refrigerante", Type: RewardTypeFreeProduct, Points: 50, ProductID: &productID})
+	assert.Nil(t, freeProduct.Validate())
+
+	_, err := freeProduct.DiscountOn(40, 8)
+	assert.EqualError(t, err, ErrRewardInactive.Error())
+
+	freeProduct.IsActive = true
+	discount, err := freeProduct.DiscountOn(40, 8)
+	assert.Nil(t, err)
+	assert.Equal(t, 8.0, discount)
+
+	discountReward := NewReward(RewardCommonAttributes{Name: "R$ 20", Type: RewardTypeDiscount, Points: 100, IsActive: true})
+	assert.EqualError(t, discountReward.Validate(), ErrRewardDiscountInvalid.Error())
+
+	discountReward.Discount = 20
+	discount, err = discountReward.DiscountOn(15, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, 15.0, discount)
+}
+
+func TestReverseTransaction(t *testing.T) {
+	reward := NewReward(RewardCommonAttributes{Name: "R$ 20", Type: RewardTypeDiscount, Points: 100, Discount: 20, IsActive: true})
+	clientID, orderID := uuid.New(), uuid.New()
+
+	earn := NewEarnTransaction(clientID, orderID, 12, 150)
+	redeem := NewRedeemTransaction(clientID, orderID, reward, 20)
+	assert.Equal(t, 50, Balance([]LoyaltyTransaction{*earn, *redeem}))
+
+	reversal, err := redeem.Reverse(time.Now())
+	assert.Nil(t, err)
+	assert.Equal(t, 100, reversal.Points)
+	assert.Equal(t, redeem.ID, *reversal.ReversalOfID)
+	assert.False(t, redeem.IsReversible())
+	assert.False(t, reversal.IsReversible())
+	assert.Equal(t, 150, Balance([]LoyaltyTransaction{*earn, *redeem, *reversal}))
+
+	_, err = redeem.Reverse(time.Now())
+	assert.EqualError(t, err, ErrTransactionAlreadyReversed.Error())
+}
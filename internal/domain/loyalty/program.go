@@ -0,0 +1,58 @@
+package loyaltyentity
+
+import (
+	"errors"
+	"math"
+
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+)
+
+var (
+	ErrProgramInactive          = errors.New("loyalty program is inactive")
+	ErrPointsPerCurrencyInvalid = errors.New("points per currency must be positive")
+)
+
+// LoyaltyProgram is the configuration of the company, a single row on each schema.
+type LoyaltyProgram struct {
+	entity.Entity
+	bun.BaseModel `bun:"table:loyalty_programs"`
+	LoyaltyProgramCommonAttributes
+}
+
+type LoyaltyProgramCommonAttributes struct {
+	IsActive          bool    `bun:"is_active" json:"is_active"`
+	PointsPerCurrency float64 `bun:"points_per_currency,notnull" json:"points_per_currency"`
+}
+
+type PatchLoyaltyProgram struct {
+	IsActive          *bool    `json:"is_active"`
+	PointsPerCurrency *float64 `json:"points_per_currency"`
+}
+
+// NewLoyaltyProgram returns the program of companies that never configured it, inactive with 1 point per currency unit.
+func NewLoyaltyProgram() *LoyaltyProgram {
+	return &LoyaltyProgram{
+		Entity: entity.NewEntity(),
+		LoyaltyProgramCommonAttributes: LoyaltyProgramCommonAttributes{
+			PointsPerCurrency: 1,
+		},
+	}
+}
+
+func (p *LoyaltyProgram) Validate() error {
+	if p.PointsPerCurrency <= 0 {
+		return ErrPointsPerCurrencyInvalid
+	}
+
+	return nil
+}
+
+// PointsFor returns the points earned by the amount paid, fractions of a point are discarded.
+func (p *LoyaltyProgram) PointsFor(amount float64) int {
+	if !p.IsActive || amount <= 0 {
+		return 0
+	}
+
+	return int(math.Floor(amount * p.PointsPerCurrency))
+}
@@ -0,0 +1,28 @@
+package loyaltyentity
+
+import (
+	"context"
+
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+	shiftentity "github.com/willjrcom/sales-backend-go/internal/domain/shift"
+)
+
+type ProgramRepository interface {
+	SaveProgram(ctx context.Context, program *LoyaltyProgram) error
+	GetProgram(ctx context.Context) (*LoyaltyProgram, error)
+}
+
+type RewardRepository interface {
+	RegisterReward(ctx context.Context, reward *Reward) error
+	UpdateReward(ctx context.Context, reward *Reward) error
+	GetRewardById(ctx context.Context, id string) (*Reward, error)
+	GetAllRewards(ctx context.Context) ([]Reward, error)
+}
+
+type TransactionRepository interface {
+	RegisterTransaction(ctx context.Context, transaction *LoyaltyTransaction) error
+	ReverseTransaction(ctx context.Context, transaction *LoyaltyTransaction, reversal *LoyaltyTransaction) error
+	RedeemReward(ctx context.Context, transaction *LoyaltyTransaction, order *orderentity.Order, redeem *shiftentity.Redeem) error
+	GetTransactionsByClientID(ctx context.Context, clientID string) ([]LoyaltyTransaction, error)
+	GetTransactionsByOrderID(ctx context.Context, orderID string) ([]LoyaltyTransaction, error)
+}
@@ -0,0 +1,105 @@
+package loyaltyentity
+
+import (
+	"errors"
+	"math"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+)
+
+var (
+	ErrRewardNameRequired      = errors.New("reward name is required")
+	ErrRewardTypeInvalid       = errors.New("reward type must be free_product or discount")
+	ErrRewardPointsInvalid     = errors.New("reward points must be positive")
+	ErrRewardProductRequired   = errors.New("product is required for free product rewards")
+	ErrRewardDiscountInvalid   = errors.New("discount must be positive for discount rewards")
+	ErrRewardInactive          = errors.New("reward is inactive")
+	ErrInsufficientPoints      = errors.New("insufficient points")
+	ErrRewardWithoutDiscountOn = errors.New("reward has no value on this order")
+)
+
+type RewardType string
+
+const (
+	RewardTypeFreeProduct RewardType = "free_product"
+	RewardTypeDiscount    RewardType = "discount"
+)
+
+// Reward is an item of the catalogue, it is redeemed as a discount on the order.
+type Reward struct {
+	entity.Entity
+	bun.BaseModel `bun:"table:loyalty_rewards"`
+	RewardCommonAttributes
+}
+
+type RewardCommonAttributes struct {
+	Name      string     `bun:"name,notnull" json:"name"`
+	Type      RewardType `bun:"type,notnull" json:"type"`
+	Points    int        `bun:"points,notnull" json:"points"`
+	ProductID *uuid.UUID `bun:"column:product_id,type:uuid" json:"product_id,omitempty"`
+	Discount  float64    `bun:"discount" json:"discount,omitempty"`
+	IsActive  bool       `bun:"is_active" json:"is_active"`
+}
+
+type PatchReward struct {
+	Name      *string     `json:"name"`
+	Type      *RewardType `json:"type"`
+	Points    *int        `json:"points"`
+	ProductID *uuid.UUID  `json:"product_id"`
+	Discount  *float64    `json:"discount"`
+	IsActive  *bool       `json:"is_active"`
+}
+
+func NewReward(rewardCommonAttributes RewardCommonAttributes) *Reward {
+	return &Reward{
+		Entity:                 entity.NewEntity(),
+		RewardCommonAttributes: rewardCommonAttributes,
+	}
+}
+
+func (r *Reward) Validate() error {
+	if r.Name == "" {
+		return ErrRewardNameRequired
+	}
+
+	if r.Points <= 0 {
+		return ErrRewardPointsInvalid
+	}
+
+	switch r.Type {
+	case RewardTypeFreeProduct:
+		if r.ProductID == nil {
+			return ErrRewardProductRequired
+		}
+	case RewardTypeDiscount:
+		if r.Discount <= 0 {
+			return ErrRewardDiscountInvalid
+		}
+	default:
+		return ErrRewardTypeInvalid
+	}
+
+	return nil
+}
+
+// DiscountOn returns the discount on the order, the price of the product for free product rewards, limited to the order total.
+func (r *Reward) DiscountOn(orderTotal float64, productPrice float64) (float64, error) {
+	if !r.IsActive {
+		return 0, ErrRewardInactive
+	}
+
+	discount := r.Discount
+	if r.Type == RewardTypeFreeProduct {
+		discount = productPrice
+	}
+
+	discount = math.Min(discount, orderTotal)
+
+	if discount <= 0 {
+		return 0, ErrRewardWithoutDiscountOn
+	}
+
+	return math.Round(discount*100) / 100, nil
+}
@@ -0,0 +1,105 @@
+package loyaltyentity
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+)
+
+var (
+	ErrTransactionAlreadyReversed = errors.New("loyalty transaction already reversed")
+)
+
+type TransactionType string
+
+const (
+	TransactionTypeEarn     TransactionType = "earn"
+	TransactionTypeRedeem   TransactionType = "redeem"
+	TransactionTypeReversal TransactionType = "reversal"
+)
+
+// LoyaltyTransaction is a line of the statement of the client, the balance is the sum of the points.
+type LoyaltyTransaction struct {
+	entity.Entity
+	bun.BaseModel `bun:"table:loyalty_transactions"`
+	LoyaltyTransactionCommonAttributes
+}
+
+type LoyaltyTransactionCommonAttributes struct {
+	ClientID     uuid.UUID       `bun:"column:client_id,type:uuid,notnull" json:"client_id"`
+	Type         TransactionType `bun:"type,notnull" json:"type"`
+	Points       int             `bun:"points,notnull" json:"points"`
+	Description  string          `bun:"description" json:"description"`
+	OrderID      *uuid.UUID      `bun:"column:order_id,type:uuid" json:"order_id,omitempty"`
+	RewardID     *uuid.UUID      `bun:"column:reward_id,type:uuid" json:"reward_id,omitempty"`
+	Discount     float64         `bun:"discount" json:"discount,omitempty"`
+	ReversalOfID *uuid.UUID      `bun:"column:reversal_of_id,type:uuid" json:"reversal_of_id,omitempty"`
+	ReversedAt   *time.Time      `bun:"reversed_at" json:"reversed_at,omitempty"`
+}
+
+func NewEarnTransaction(clientID uuid.UUID, orderID uuid.UUID, orderNumber int, points int) *LoyaltyTransaction {
+	return newTransaction(LoyaltyTransactionCommonAttributes{
+		ClientID:    clientID,
+		Type:        TransactionTypeEarn,
+		Points:      points,
+		Description: "Pontos do pedido " + strconv.Itoa(orderNumber),
+		OrderID:     &orderID,
+	})
+}
+
+func NewRedeemTransaction(clientID uuid.UUID, orderID uuid.UUID, reward *Reward, discount float64) *LoyaltyTransaction {
+	return newTransaction(LoyaltyTransactionCommonAttributes{
+		ClientID:    clientID,
+		Type:        TransactionTypeRedeem,
+		Points:      -reward.Points,
+		Description: "Resgate: " + reward.Name,
+		OrderID:     &orderID,
+		RewardID:    &reward.ID,
+		Discount:    discount,
+	})
+}
+
+// Reverse marks the transaction as reversed and returns the transaction that cancels its points.
+func (t *LoyaltyTransaction) Reverse(now time.Time) (*LoyaltyTransaction, error) {
+	if t.ReversedAt != nil {
+		return nil, ErrTransactionAlreadyReversed
+	}
+
+	t.ReversedAt = &now
+
+	return newTransaction(LoyaltyTransactionCommonAttributes{
+		ClientID:     t.ClientID,
+		Type:         TransactionTypeReversal,
+		Points:       -t.Points,
+		Description:  "Estorno: " + t.Description,
+		OrderID:      t.OrderID,
+		RewardID:     t.RewardID,
+		Discount:     -t.Discount,
+		ReversalOfID: &t.ID,
+	}), nil
+}
+
+func (t *LoyaltyTransaction) IsReversible() bool {
+	return t.Type != TransactionTypeReversal && t.ReversedAt == nil
+}
+
+func Balance(transactions []LoyaltyTransaction) int {
+	balance := 0
+
+	for _, transaction := range transactions {
+		balance += transaction.Points
+	}
+
+	return balance
+}
+
+func newTransaction(attributes LoyaltyTransactionCommonAttributes) *LoyaltyTransaction {
+	return &LoyaltyTransaction{
+		Entity:                             entity.NewEntity(),
+		LoyaltyTransactionCommonAttributes: attributes,
+	}
+}
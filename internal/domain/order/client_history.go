@@ -0,0 +1,87 @@
+package orderentity
+
+import (
+	"sort"
+
+	"github.com/google/uuid"
+	cliententity "github.com/willjrcom/sales-backend-go/internal/domain/client"
+	itementity "github.com/willjrcom/sales-backend-go/internal/domain/item"
+)
+
+// FavouriteItem is an item ordered by the client and the quantity ordered on completed orders.
+type FavouriteItem struct {
+	Name     string  `json:"name"`
+	Quantity float64 `json:"quantity"`
+}
+
+// IsCompleted is true for finished orders, also after being archived.
+func (o *Order) IsCompleted() bool {
+	return o.FinishedAt != nil && o.CanceledAt == nil
+}
+
+func ClientStatsFromOrders(clientID uuid.UUID, orders []Order) cliententity.ClientStats {
+	stats := cliententity.ClientStats{ClientID: clientID}
+
+	for i := range orders {
+		order := &orders[i]
+		if !order.IsCompleted() {
+			continue
+		}
+
+		stats.OrderCount++
+		stats.TotalSpent += order.TotalPayable
+
+		orderedAt := order.CreatedAt
+		if order.PendingAt != nil {
+			orderedAt = *order.PendingAt
+		}
+
+		if stats.FirstOrderAt == nil || orderedAt.Before(*stats.FirstOrderAt) {
+			stats.FirstOrderAt = &orderedAt
+		}
+
+		if stats.LastOrderAt == nil || orderedAt.After(*stats.LastOrderAt) {
+			stats.LastOrderAt = &orderedAt
+		}
+	}
+
+	return stats
+}
+
+// FavouriteItems returns the items most ordered on completed orders, at most limit items.
+func FavouriteItems(orders []Order, limit int) []FavouriteItem {
+	quantities := map[string]float64{}
+
+	for i := range orders {
+		if !orders[i].IsCompleted() {
+			continue
+		}
+
+		for _, group := range orders[i].Groups {
+			for _, item := range group.Items {
+				if item.Status != itementity.StatusItemCanceled {
+					quantities[item.Name] += item.Quantity
+				}
+			}
+		}
+	}
+
+	favourites := []FavouriteItem{}
+	for name, quantity := range quantities {
+		favourites = append(favourites, FavouriteItem{Name: name, Quantity: quantity})
+	}
+
+	sort.Slice(favourites, func(i, j int) bool {
+		if favourites[i].Quantity != favourites[j].Quantity {
+			return favourites[i].Quantity > favourites[j].Quantity
+		}
+
+		return favourites[i].Name < favourites[j].Name
+	})
+
+	if len(favourites) > limit {
+		favourites = favourites[:limit]
+	}
+
+	return favourites
+}
@@ -0,0 +1,57 @@
+package orderentity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	groupitementity "github.com/willjrcom/sales-backend-go/internal/domain/group_item"
+	itementity "github.com/willjrcom/sales-backend-go/internal/domain/item"
+)
+
+func TestClientStatsFromOrders(t *testing.T) {
+	first := time.Date(2024, 5, 1, 19, 0, 0, 0, time.UTC)
+	last := first.AddDate(0, 0, 10)
+
+	finished := func(pendingAt time.Time, total float64, items ...itementity.Item) Order {
+		order := Order{}
+		order.PendingAt = &pendingAt
+		order.FinishedAt = &pendingAt
+		order.TotalPayable = total
+		group := groupitementity.GroupItem{}
+		group.Items = items
+		order.Groups = []groupitementity.GroupItem{group}
+		return order
+	}
+
+	pizza := itementity.Item{}
+	pizza.Name = "pizza"
+	pizza.Quantity = 2
+
+	soda := itementity.Item{}
+	soda.Name = "soda"
+	soda.Quantity = 1
+
+	canceledItem := itementity.Item{}
+	canceledItem.Name = "soda"
+	canceledItem.Quantity = 5
+	canceledItem.Status = itementity.StatusItemCanceled
+
+	canceled := finished(last.AddDate(0, 0, 1), 500, soda)
+	canceled.CanceledAt = &last
+
+	orders := []Order{finished(last, 60, pizza, soda), finished(first, 40, pizza, canceledItem), canceled}
+
+	clientID := uuid.New()
+	stats := ClientStatsFromOrders(clientID, orders)
+	assert.Equal(t, clientID, stats.ClientID)
+	assert.Equal(t, 2, stats.OrderCount)
+	assert.Equal(t, 100.0, stats.TotalSpent)
+	assert.Equal(t, first, *stats.FirstOrderAt)
+	assert.Equal(t, last, *stats.LastOrderAt)
+
+	favourites := FavouriteItems(orders, 5)
+	assert.Equal(t, []FavouriteItem{{Name: "pizza", Quantity: 4}, {Name: "soda", Quantity: 1}}, favourites)
+	assert.Len(t, FavouriteItems(orders, 1), 1)
+}
@@ -1,16 +1,28 @@
 package orderentity
 
 import (
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/uptrace/bun"
 	addressentity "github.com/willjrcom/sales-backend-go/internal/domain/address"
 	cliententity "github.com/willjrcom/sales-backend-go/internal/domain/client"
+	deliveryzoneentity "github.com/willjrcom/sales-backend-go/internal/domain/delivery_zone"
 	employeeentity "github.com/willjrcom/sales-backend-go/internal/domain/employee"
 	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
 )
 
+var (
+	ErrDeliveryNotFromDriver    = errors.New("delivery is not assigned to the driver")
+	ErrDeliveryNotLaunched      = errors.New("delivery is not launched")
+	ErrDeliveryAlreadyDelivered = errors.New("delivery already delivered")
+	ErrDeliveryAlreadyAccepted  = errors.New("delivery already accepted")
+	ErrDeliveryAlreadyPickedUp  = errors.New("delivery already picked up")
+	ErrDeliveryTransition       = errors.New("delivery can not change to this status")
+	ErrDeliveryReasonRequired   = errors.New("reason is required")
+)
+
 type DeliveryOrder struct {
 	entity.Entity
 	bun.BaseModel `bun:"table:delivery_orders,alias:delivery"`
@@ -28,10 +40,38 @@ type DeliveryOrderCommonAttributes struct {
 	DriverID    *uuid.UUID               `bun:"column:driver_id,type:uuid" json:"driver_id"`
 	Driver      *employeeentity.Employee `bun:"rel:belongs-to" json:"driver"`
 	OrderID     uuid.UUID                `bun:"column:order_id,type:uuid,notnull" json:"order_id"`
+	// SettlementID is the driver settlement that paid the delivery
+	SettlementID *uuid.UUID `bun:"column:settlement_id,type:uuid" json:"settlement_id,omitempty"`
+	DeliveryConditions
+	DeliveryRoute
+	DeliveryProof
+	FailureReason string               `bun:"failure_reason" json:"failure_reason,omitempty"`
+	Transitions   []DeliveryTransition `bun:"transitions,type:jsonb" json:"transitions,omitempty"`
+}
+
+// DeliveryConditions are the zone of the delivery and its conditions at the time the address was chosen.
+type DeliveryConditions struct {
+	DeliveryZoneID *uuid.UUID    `bun:"column:delivery_zone_id,type:uuid" json:"delivery_zone_id,omitempty"`
+	MinOrderValue  float64       `bun:"min_order_value" json:"min_order_value,omitempty"`
+	EstimatedTime  time.Duration `bun:"estimated_time" json:"estimated_time,omitempty"`
+}
+
+// DeliveryRoute is the run of the driver the delivery was launched in, stops start at 1.
+type DeliveryRoute struct {
+	BatchID *uuid.UUID `bun:"column:batch_id,type:uuid" json:"batch_id,omitempty"`
+	Stop    int        `bun:"stop" json:"stop,omitempty"`
+}
+
+// DeliveryProof is what the driver registered when handing the order, the photo is the key on the storage.
+type DeliveryProof struct {
+	RecipientName string `bun:"recipient_name" json:"recipient_name,omitempty"`
+	PhotoKey      string `bun:"photo_key" json:"photo_key,omitempty"`
 }
 
 type DeliveryTimeLogs struct {
+	AcceptedAt  *time.Time `bun:"accepted_at" json:"accepted_at,omitempty"`
 	LaunchedAt  *time.Time `bun:"launched_at" json:"launched_at,omitempty"`
+	PickedUpAt  *time.Time `bun:"picked_up_at" json:"picked_up_at,omitempty"`
 	DeliveredAt *time.Time `bun:"delivered_at" json:"delivered_at,omitempty"`
 }
 
@@ -47,15 +87,184 @@ func NewDeliveryOrder(clientID uuid.UUID) *DeliveryOrder {
 	}
 }
 
-func (d *DeliveryOrder) LaunchDelivery(driverID uuid.UUID) {
-	*d.DriverID = driverID
+// ApplyQuote charges the fee of the zone of the address.
+func (d *DeliveryOrder) ApplyQuote(quote *deliveryzoneentity.DeliveryQuote) {
+	fee := quote.Fee
+	d.DeliveryTax = &fee
+	d.DeliveryZoneID = quote.DeliveryZoneID
+	d.MinOrderValue = quote.MinOrderValue
+	d.EstimatedTime = quote.EstimatedTime
+}
+
+// ReadyDelivery marks the order as ready to be dispatched.
+func (d *DeliveryOrder) ReadyDelivery() error {
+	return d.changeStatus(DeliveryOrderStatusReady, "")
+}
+
+// LaunchDelivery dispatches the delivery with the driver, failed and returned deliveries are dispatched again.
+func (d *DeliveryOrder) LaunchDelivery(driverID uuid.UUID) error {
+	if err := d.changeStatus(DeliveryOrderStatusDispatched, ""); err != nil {
+		return err
+	}
+
+	d.DriverID = &driverID
 	d.LaunchedAt = &time.Time{}
 	*d.LaunchedAt = time.Now()
-	d.Status = DeliveryOrderStatusShipped
+	d.PickedUpAt = nil
+	d.FailureReason = ""
+	return nil
 }
 
-func (d *DeliveryOrder) FinishDelivery() {
+// LaunchInBatch launches the delivery as a stop of the run of the driver.
+func (d *DeliveryOrder) LaunchInBatch(driverID uuid.UUID, batchID uuid.UUID, stop int) error {
+	if err := d.LaunchDelivery(driverID); err != nil {
+		return err
+	}
+
+	d.BatchID = &batchID
+	d.Stop = stop
+	return nil
+}
+
+func (d *DeliveryOrder) FinishDelivery() error {
+	if d.DeliveredAt != nil {
+		return ErrDeliveryAlreadyDelivered
+	}
+
+	if d.Status != DeliveryOrderStatusDispatched {
+		return ErrDeliveryNotLaunched
+	}
+
+	if err := d.changeStatus(DeliveryOrderStatusDelivered, ""); err != nil {
+		return err
+	}
+
 	d.DeliveredAt = &time.Time{}
 	*d.DeliveredAt = time.Now()
-	d.Status = DeliveryOrderStatusDelivered
+	return nil
+}
+
+// FailDelivery records why the driver could not hand the order.
+func (d *DeliveryOrder) FailDelivery(reason string) error {
+	if reason == "" {
+		return ErrDeliveryReasonRequired
+	}
+
+	if err := d.changeStatus(DeliveryOrderStatusFailed, reason); err != nil {
+		return err
+	}
+
+	d.FailureReason = reason
+	return nil
+}
+
+// ReturnDelivery records the order is back at the store after a failed delivery.
+func (d *DeliveryOrder) ReturnDelivery() error {
+	return d.changeStatus(DeliveryOrderStatusReturned, "")
+}
+
+// CancelDelivery closes the delivery before it is handed, the order is not delivered anymore.
+func (d *DeliveryOrder) CancelDelivery(reason string) error {
+	if reason == "" {
+		return ErrDeliveryReasonRequired
+	}
+
+	return d.changeStatus(DeliveryOrderStatusCanceled, reason)
+}
+
+func (d *DeliveryOrder) changeStatus(to StatusDeliveryOrder, reason string) error {
+	if !d.Status.CanTransitionTo(to) {
+		return ErrDeliveryTransition
+	}
+
+	d.Transitions = append(d.Transitions, DeliveryTransition{
+		From:      d.Status,
+		To:        to,
+		Reason:    reason,
+		ChangedAt: time.Now(),
+	})
+	d.Status = to
+	return nil
+}
+
+// CheckDriver rejects the actions of a driver on deliveries of other drivers.
+func (d *DeliveryOrder) CheckDriver(driverID uuid.UUID) error {
+	if d.DriverID == nil || *d.DriverID != driverID {
+		return ErrDeliveryNotFromDriver
+	}
+
+	return nil
+}
+
+// AcceptDelivery confirms the driver will take the delivery assigned by the store.
+func (d *DeliveryOrder) AcceptDelivery(driverID uuid.UUID) error {
+	if err := d.CheckDriver(driverID); err != nil {
+		return err
+	}
+
+	if d.DeliveredAt != nil {
+		return ErrDeliveryAlreadyDelivered
+	}
+
+	if d.Status.IsClosed() {
+		return ErrDeliveryTransition
+	}
+
+	if d.AcceptedAt != nil {
+		return ErrDeliveryAlreadyAccepted
+	}
+
+	now := time.Now()
+	d.AcceptedAt = &now
+	return nil
+}
+
+// PickupDelivery confirms the driver took the order at the store, launching it when the store did not.
+func (d *DeliveryOrder) PickupDelivery(driverID uuid.UUID) error {
+	if err := d.CheckDriver(driverID); err != nil {
+		return err
+	}
+
+	if d.DeliveredAt != nil {
+		return ErrDeliveryAlreadyDelivered
+	}
+
+	if d.PickedUpAt != nil {
+		return ErrDeliveryAlreadyPickedUp
+	}
+
+	if d.Status != DeliveryOrderStatusDispatched {
+		if err := d.LaunchDelivery(driverID); err != nil {
+			return err
+		}
+	}
+
+	now := time.Now()
+	d.PickedUpAt = &now
+
+	if d.AcceptedAt == nil {
+		d.AcceptedAt = &now
+	}
+
+	return nil
+}
+
+// DeliverWithProof finishes the delivery of the driver, the proof is optional.
+func (d *DeliveryOrder) DeliverWithProof(driverID uuid.UUID, proof *DeliveryProof) error {
+	if err := d.CheckDriver(driverID); err != nil {
+		return err
+	}
+
+	if err := d.FinishDelivery(); err != nil {
+		return err
+	}
+
+	if proof != nil {
+		d.RecipientName = proof.RecipientName
+		if proof.PhotoKey != "" {
+			d.PhotoKey = proof.PhotoKey
+		}
+	}
+
+	return nil
 }
@@ -0,0 +1,77 @@
+package orderentity
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDriverDeliveryFlow(t *testing.T) {
+	driverID, otherDriverID := uuid.New(), uuid.New()
+
+	delivery := NewDeliveryOrder(uuid.New())
+	assert.EqualError(t, delivery.AcceptDelivery(driverID), ErrDeliveryNotFromDriver.Error())
+
+	delivery.DriverID = &driverID
+	assert.Nil(t, delivery.AcceptDelivery(driverID))
+	assert.EqualError(t, delivery.AcceptDelivery(driverID), ErrDeliveryAlreadyAccepted.Error())
+
+	assert.EqualError(t, delivery.DeliverWithProof(driverID, nil), ErrDeliveryNotLaunched.Error())
+
+	assert.Nil(t, delivery.PickupDelivery(driverID))
+	assert.NotNil(t, delivery.LaunchedAt)
+	assert.Equal(t, DeliveryOrderStatusDispatched, delivery.Status)
+	assert.EqualError(t, delivery.PickupDelivery(driverID), ErrDeliveryAlreadyPickedUp.Error())
+
+	assert.EqualError(t, delivery.DeliverWithProof(otherDriverID, nil), ErrDeliveryNotFromDriver.Error())
+
+	delivery.PhotoKey = "photo"
+	assert.Nil(t, delivery.DeliverWithProof(driverID, &DeliveryProof{RecipientName: "Maria"}))
+	assert.Equal(t, "Maria", delivery.RecipientName)
+	assert.Equal(t, "photo", delivery.PhotoKey)
+	assert.Equal(t, DeliveryOrderStatusDelivered, delivery.Status)
+
+	assert.EqualError(t, delivery.FinishDelivery(), ErrDeliveryAlreadyDelivered.Error())
+}
+
+func TestDeliveryFailAndReturn(t *testing.T) {
+	driverID := uuid.New()
+
+	delivery := NewDeliveryOrder(uuid.New())
+	assert.EqualError(t, delivery.FailDelivery("client absent"), ErrDeliveryTransition.Error())
+
+	assert.Nil(t, delivery.ReadyDelivery())
+	assert.Nil(t, delivery.LaunchDelivery(driverID))
+	assert.EqualError(t, delivery.FailDelivery(""), ErrDeliveryReasonRequired.Error())
+	assert.Nil(t, delivery.FailDelivery("client absent"))
+	assert.Equal(t, "client absent", delivery.FailureReason)
+
+	assert.Nil(t, delivery.ReturnDelivery())
+	assert.Nil(t, delivery.LaunchDelivery(driverID))
+	assert.Equal(t, "", delivery.FailureReason)
+	assert.Nil(t, delivery.FinishDelivery())
+
+	assert.EqualError(t, delivery.CancelDelivery("client gave up"), ErrDeliveryTransition.Error())
+	assert.Len(t, delivery.Transitions, 6)
+	assert.Equal(t, DeliveryOrderStatusFailed, delivery.Transitions[2].To)
+	assert.Equal(t, "client absent", delivery.Transitions[2].Reason)
+}
+
+func TestRefundPayments(t *testing.T) {
+	driverID := uuid.New()
+	order := &Order{}
+
+	cash := NewPayment(30, Dinheiro, order.ID)
+	cash.DriverID = &driverID
+	order.AddPayment(cash)
+	order.AddPayment(NewPayment(20, Visa, order.ID))
+
+	refunded := order.RefundPayments(&driverID)
+	assert.Len(t, refunded, 1)
+	assert.Equal(t, 20.0, order.TotalPaid)
+
+	assert.Len(t, order.RefundPayments(nil), 1)
+	assert.Equal(t, 0.0, order.TotalPaid)
+	assert.Len(t, order.RefundPayments(nil), 0)
+}
@@ -0,0 +1,132 @@
+package orderentity
+
+import (
+	"sort"
+	"strings"
+
+	deliveryzoneentity "github.com/willjrcom/sales-backend-go/internal/domain/delivery_zone"
+)
+
+const DefaultBatchSize = 3
+
+// DeliveryBatch is a suggested run for one driver, the stops are in the order of the route.
+type DeliveryBatch struct {
+	Stops      []DeliveryOrder `json:"stops"`
+	DistanceKm float64         `json:"distance_km"`
+}
+
+// PlanBatches groups the deliveries by zone, or by neighborhood when there is no zone,
+// and splits the route of each group in batches with at most size stops.
+// The groups with the oldest deliveries come first.
+func PlanBatches(deliveries []DeliveryOrder, store *deliveryzoneentity.Point, size int) []DeliveryBatch {
+	if size <= 0 {
+		size = DefaultBatchSize
+	}
+
+	sorted := make([]DeliveryOrder, len(deliveries))
+	copy(sorted, deliveries)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].CreatedAt.Before(sorted[j].CreatedAt)
+	})
+
+	keys := []string{}
+	groups := map[string][]DeliveryOrder{}
+
+	for _, delivery := range sorted {
+		key := batchKey(&delivery)
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+
+		groups[key] = append(groups[key], delivery)
+	}
+
+	batches := []DeliveryBatch{}
+
+	for _, key := range keys {
+		route, _ := RouteStops(groups[key], store)
+
+		for start := 0; start < len(route); start += size {
+			stops := route[start:min(start+size, len(route))]
+			batches = append(batches, DeliveryBatch{
+				Stops:      stops,
+				DistanceKm: RouteDistanceKm(stops, store),
+			})
+		}
+	}
+
+	return batches
+}
+
+// RouteStops orders the deliveries with the nearest neighbour heuristic starting at the store.
+// Deliveries whose address has no coordinates are kept at the end, in the given order.
+func RouteStops(deliveries []DeliveryOrder, store *deliveryzoneentity.Point) ([]DeliveryOrder, float64) {
+	located := []DeliveryOrder{}
+	unlocated := []DeliveryOrder{}
+
+	for _, delivery := range deliveries {
+		if deliveryzoneentity.PointOf(delivery.Address) == nil {
+			unlocated = append(unlocated, delivery)
+			continue
+		}
+
+		located = append(located, delivery)
+	}
+
+	route := make([]DeliveryOrder, 0, len(deliveries))
+	current := store
+
+	for len(located) > 0 {
+		next := 0
+
+		if current != nil {
+			nearest := -1.0
+			for i := range located {
+				distance := deliveryzoneentity.DistanceKm(*current, *deliveryzoneentity.PointOf(located[i].Address))
+				if nearest < 0 || distance < nearest {
+					next, nearest = i, distance
+				}
+			}
+		}
+
+		route = append(route, located[next])
+		current = deliveryzoneentity.PointOf(located[next].Address)
+		located = append(located[:next], located[next+1:]...)
+	}
+
+	route = append(route, unlocated...)
+	return route, RouteDistanceKm(route, store)
+}
+
+// RouteDistanceKm is the straight line distance from the store through the stops with coordinates.
+func RouteDistanceKm(stops []DeliveryOrder, store *deliveryzoneentity.Point) float64 {
+	total := 0.0
+	current := store
+
+	for _, stop := range stops {
+		point := deliveryzoneentity.PointOf(stop.Address)
+		if point == nil {
+			continue
+		}
+
+		if current != nil {
+			total += deliveryzoneentity.DistanceKm(*current, *point)
+		}
+
+		current = point
+	}
+
+	return total
+}
+
+func batchKey(delivery *DeliveryOrder) string {
+	if delivery.DeliveryZoneID != nil {
+		return delivery.DeliveryZoneID.String()
+	}
+
+	if delivery.Address == nil {
+		return ""
+	}
+
+	return strings.ToLower(strings.TrimSpace(delivery.Address.Neighborhood))
+}
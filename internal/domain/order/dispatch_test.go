@@ -0,0 +1,74 @@
+package orderentity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	addressentity "github.com/willjrcom/sales-backend-go/internal/domain/address"
+	deliveryzoneentity "github.com/willjrcom/sales-backend-go/internal/domain/delivery_zone"
+)
+
+func newDispatchDelivery(createdAt time.Time, neighborhood string, point *deliveryzoneentity.Point) DeliveryOrder {
+	delivery := *NewDeliveryOrder(uuid.New())
+	delivery.CreatedAt = createdAt
+	delivery.Address = &addressentity.Address{}
+	delivery.Address.Neighborhood = neighborhood
+
+	if point != nil {
+		delivery.Address.Latitude = &point.Latitude
+		delivery.Address.Longitude = &point.Longitude
+	}
+
+	return delivery
+}
+
+func TestRouteStops(t *testing.T) {
+	now := time.Now()
+	store := &deliveryzoneentity.Point{Latitude: 0, Longitude: 0}
+
+	far := newDispatchDelivery(now, "Centro", &deliveryzoneentity.Point{Latitude: 0, Longitude: 0.03})
+	near := newDispatchDelivery(now, "Centro", &deliveryzoneentity.Point{Latitude: 0, Longitude: 0.01})
+	unknown := newDispatchDelivery(now, "Centro", nil)
+	middle := newDispatchDelivery(now, "Centro", &deliveryzoneentity.Point{Latitude: 0, Longitude: 0.02})
+
+	route, distance := RouteStops([]DeliveryOrder{far, unknown, near, middle}, store)
+
+	assert.Equal(t, []uuid.UUID{near.ID, middle.ID, far.ID, unknown.ID}, []uuid.UUID{route[0].ID, route[1].ID, route[2].ID, route[3].ID})
+	assert.InDelta(t, deliveryzoneentity.DistanceKm(*store, deliveryzoneentity.Point{Latitude: 0, Longitude: 0.03}), distance, 0.001)
+}
+
+func TestPlanBatches(t *testing.T) {
+	now := time.Now()
+	zoneID := uuid.New()
+
+	deliveries := []DeliveryOrder{
+		newDispatchDelivery(now.Add(2*time.Minute), "Moema", nil),
+		newDispatchDelivery(now, "Centro", nil),
+		newDispatchDelivery(now.Add(time.Minute), "centro ", nil),
+		newDispatchDelivery(now.Add(3*time.Minute), "Centro", nil),
+		newDispatchDelivery(now.Add(4*time.Minute), "Centro", nil),
+	}
+	deliveries[0].DeliveryZoneID = &zoneID
+
+	batches := PlanBatches(deliveries, nil, 3)
+
+	assert.Len(t, batches, 3)
+	assert.Len(t, batches[0].Stops, 3)
+	assert.Equal(t, deliveries[1].ID, batches[0].Stops[0].ID)
+	assert.Len(t, batches[1].Stops, 1)
+	assert.Equal(t, deliveries[0].ID, batches[2].Stops[0].ID)
+}
+
+func TestLaunchInBatch(t *testing.T) {
+	delivery := NewDeliveryOrder(uuid.New())
+	driverID, batchID := uuid.New(), uuid.New()
+
+	delivery.LaunchInBatch(driverID, batchID, 2)
+
+	assert.Equal(t, driverID, *delivery.DriverID)
+	assert.Equal(t, batchID, *delivery.BatchID)
+	assert.Equal(t, 2, delivery.Stop)
+	assert.NotNil(t, delivery.LaunchedAt)
+}
@@ -0,0 +1,189 @@
+package orderentity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	groupitementity "github.com/willjrcom/sales-backend-go/internal/domain/group_item"
+)
+
+var (
+	// EtaHistoryWindow is how far back the past orders are used to predict the times.
+	EtaHistoryWindow = 14 * 24 * time.Hour
+	// MinEtaSamples is the number of past items or deliveries needed to trust their average.
+	MinEtaSamples = 5
+	// KitchenCapacity is the number of orders the kitchen prepares at the same time.
+	KitchenCapacity = 4
+)
+
+// OrderEta is the predicted time the order is ready and delivered, refined as the order progresses.
+type OrderEta struct {
+	ReadyAt     *time.Time `json:"ready_at,omitempty"`
+	DeliveredAt *time.Time `json:"delivered_at,omitempty"`
+}
+
+// PreparationStats averages the past items of a category, the wait is from pending to started
+// and the preparation from started to ready.
+type PreparationStats struct {
+	CategoryID         uuid.UUID `bun:"category_id,type:uuid" json:"category_id"`
+	Samples            int       `bun:"samples" json:"samples"`
+	WaitSeconds        float64   `bun:"wait_seconds" json:"wait_seconds"`
+	PreparationSeconds float64   `bun:"preparation_seconds" json:"preparation_seconds"`
+}
+
+// TravelStats averages the past deliveries of a zone, from launched to delivered.
+type TravelStats struct {
+	DeliveryZoneID *uuid.UUID `bun:"delivery_zone_id,type:uuid" json:"delivery_zone_id,omitempty"`
+	Samples        int        `bun:"samples" json:"samples"`
+	Seconds        float64    `bun:"seconds" json:"seconds"`
+}
+
+// EtaHistory is what the store knows to predict the times of the orders.
+// The ideal times are the sum of the ideal time of the process rules of each category,
+// used when the category has not enough past items.
+type EtaHistory struct {
+	Preparations []PreparationStats
+	Travels      []TravelStats
+	IdealTimes   map[uuid.UUID]time.Duration
+	// Queue is the pending time of the orders in the kitchen.
+	Queue []time.Time
+}
+
+// Estimate predicts when the order is ready and, for deliveries, delivered.
+// Staging orders are predicted as if sent to the kitchen now, nil when the customer already has the order.
+func (o *Order) Estimate(history *EtaHistory, now time.Time) *OrderEta {
+	if o.IsTrackingDone() {
+		return nil
+	}
+
+	readyAt := o.estimateReadyAt(history, now)
+
+	if readyAt == nil {
+		return nil
+	}
+
+	eta := &OrderEta{ReadyAt: readyAt}
+
+	if o.Delivery == nil {
+		return eta
+	}
+
+	travel := history.travelTime(o.Delivery)
+
+	deliveredAt := readyAt.Add(travel)
+	if o.Delivery.LaunchedAt != nil {
+		eta.ReadyAt = o.Delivery.LaunchedAt
+		deliveredAt = latest(now, o.Delivery.LaunchedAt.Add(travel))
+	}
+
+	eta.DeliveredAt = &deliveredAt
+	return eta
+}
+
+// estimateReadyAt is the slowest group of the order, groups not started yet wait for the orders ahead in the kitchen.
+func (o *Order) estimateReadyAt(history *EtaHistory, now time.Time) *time.Time {
+	if o.Pickup != nil && o.Pickup.ReadyAt != nil {
+		return o.Pickup.ReadyAt
+	}
+
+	start := now
+	if o.StartAt != nil && o.StartAt.After(now) {
+		start = *o.StartAt
+	}
+
+	queueSince := start
+	if o.PendingAt != nil {
+		queueSince = *o.PendingAt
+	}
+
+	ahead := history.queueAhead(queueSince)
+
+	var readyAt *time.Time
+	for _, group := range o.Groups {
+		if group.Status == groupitementity.StatusGroupCanceled {
+			continue
+		}
+
+		wait, preparation := history.preparationTime(group.CategoryID)
+		wait = max(wait, time.Duration(ahead/KitchenCapacity)*preparation)
+
+		var groupReadyAt time.Time
+		switch {
+		case group.ReadyAt != nil:
+			groupReadyAt = *group.ReadyAt
+		case group.StartedAt != nil:
+			groupReadyAt = latest(now, group.StartedAt.Add(preparation))
+		case group.PendingAt != nil:
+			groupReadyAt = latest(now, group.PendingAt.Add(wait)).Add(preparation)
+		default:
+			groupReadyAt = start.Add(wait + preparation)
+		}
+
+		if readyAt == nil || groupReadyAt.After(*readyAt) {
+			readyAt = &groupReadyAt
+		}
+	}
+
+	return readyAt
+}
+
+// preparationTime returns the usual wait and preparation of the category, the ideal time without enough past items.
+func (h *EtaHistory) preparationTime(categoryID uuid.UUID) (wait time.Duration, preparation time.Duration) {
+	for _, stats := range h.Preparations {
+		if stats.CategoryID == categoryID && stats.Samples >= MinEtaSamples {
+			return seconds(stats.WaitSeconds), seconds(stats.PreparationSeconds)
+		}
+	}
+
+	return 0, h.IdealTimes[categoryID]
+}
+
+// travelTime is the usual travel of the zone, then the estimated time of the zone,
+// then the usual travel of every zone.
+func (h *EtaHistory) travelTime(delivery *DeliveryOrder) time.Duration {
+	samples, total := 0, 0.0
+
+	for _, stats := range h.Travels {
+		if delivery.DeliveryZoneID != nil && stats.DeliveryZoneID != nil && *stats.DeliveryZoneID == *delivery.DeliveryZoneID && stats.Samples >= MinEtaSamples {
+			return seconds(stats.Seconds)
+		}
+
+		samples += stats.Samples
+		total += stats.Seconds * float64(stats.Samples)
+	}
+
+	if delivery.EstimatedTime > 0 {
+		return delivery.EstimatedTime
+	}
+
+	if samples >= MinEtaSamples {
+		return seconds(total / float64(samples))
+	}
+
+	return DeliveryEstimatedTime
+}
+
+// queueAhead counts the orders in the kitchen sent before the given time.
+func (h *EtaHistory) queueAhead(since time.Time) int {
+	ahead := 0
+
+	for _, pendingAt := range h.Queue {
+		if pendingAt.Before(since) {
+			ahead++
+		}
+	}
+
+	return ahead
+}
+
+func seconds(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
+
+func latest(a time.Time, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+
+	return a
+}
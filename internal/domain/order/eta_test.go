@@ -0,0 +1,88 @@
+package orderentity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	groupitementity "github.com/willjrcom/sales-backend-go/internal/domain/group_item"
+)
+
+func TestEstimateFromHistory(t *testing.T) {
+	now := time.Date(2024, 5, 10, 19, 0, 0, 0, time.UTC)
+	pizzaID, drinkID, zoneID := uuid.New(), uuid.New(), uuid.New()
+
+	order := &Order{}
+	order.Status = OrderStatusPending
+	order.PendingAt = &now
+	order.Delivery = &DeliveryOrder{}
+	order.Delivery.DeliveryZoneID = &zoneID
+	order.Delivery.EstimatedTime = 40 * time.Minute
+
+	pizza := groupitementity.GroupItem{}
+	pizza.CategoryID = pizzaID
+	pizza.Status = groupitementity.StatusGroupPending
+	pizza.PendingAt = &now
+
+	drink := groupitementity.GroupItem{}
+	drink.CategoryID = drinkID
+	drink.Status = groupitementity.StatusGroupPending
+	drink.PendingAt = &now
+
+	order.Groups = []groupitementity.GroupItem{pizza, drink}
+
+	history := &EtaHistory{
+		IdealTimes:   map[uuid.UUID]time.Duration{pizzaID: 30 * time.Minute, drinkID: 2 * time.Minute},
+		Preparations: []PreparationStats{{CategoryID: pizzaID, Samples: 10, WaitSeconds: 300, PreparationSeconds: 1200}},
+	}
+
+	// usual wait and preparation of the pizzas, zone estimated time without past deliveries
+	eta := order.Estimate(history, now)
+	assert.Equal(t, now.Add(25*time.Minute), *eta.ReadyAt)
+	assert.Equal(t, now.Add(65*time.Minute), *eta.DeliveredAt)
+
+	// 8 orders ahead with a kitchen of 4 wait two pizzas
+	for i := 0; i < 8; i++ {
+		history.Queue = append(history.Queue, now.Add(-time.Minute))
+	}
+	history.Queue = append(history.Queue, now.Add(time.Minute))
+	assert.Equal(t, now.Add(60*time.Minute), *order.Estimate(history, now).ReadyAt)
+
+	// refined when the kitchen starts the pizza
+	history.Queue = nil
+	startedAt := now.Add(10 * time.Minute)
+	order.Groups[0].Status = groupitementity.StatusGroupStarted
+	order.Groups[0].StartedAt = &startedAt
+	order.Groups[1].Status = groupitementity.StatusGroupReady
+	order.Groups[1].ReadyAt = &startedAt
+	assert.Equal(t, now.Add(30*time.Minute), *order.Estimate(history, startedAt).ReadyAt)
+
+	// late pizza is predicted as ready now
+	late := now.Add(45 * time.Minute)
+	assert.Equal(t, late, *order.Estimate(history, late).ReadyAt)
+
+	history.Travels = []TravelStats{{DeliveryZoneID: &zoneID, Samples: 6, Seconds: 900}}
+	launchedAt := now.Add(32 * time.Minute)
+	order.Delivery.LaunchedAt = &launchedAt
+	eta = order.Estimate(history, launchedAt)
+	assert.Equal(t, launchedAt, *eta.ReadyAt)
+	assert.Equal(t, launchedAt.Add(15*time.Minute), *eta.DeliveredAt)
+}
+
+func TestEstimateStagingOrder(t *testing.T) {
+	now := time.Date(2024, 5, 10, 19, 0, 0, 0, time.UTC)
+	startAt := now.Add(2 * time.Hour)
+
+	order := &Order{}
+	order.Status = OrderStatusStaging
+	order.Pickup = &PickupOrder{}
+	assert.Nil(t, order.Estimate(&EtaHistory{}, now))
+
+	history := pendingGroup(order, 15*time.Minute)
+	assert.Equal(t, now.Add(15*time.Minute), *order.Estimate(history, now).ReadyAt)
+	assert.Nil(t, order.Estimate(history, now).DeliveredAt)
+
+	order.StartAt = &startAt
+	assert.Equal(t, startAt.Add(15*time.Minute), *order.Estimate(history, now).ReadyAt)
+}
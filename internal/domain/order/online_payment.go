@@ -0,0 +1,40 @@
+package orderentity
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrOrderAwaitingPayment    = errors.New("order is awaiting the online payment")
+	ErrOrderNotAwaitingPayment = errors.New("order is not awaiting an online payment")
+)
+
+// OnlinePayment is the payment chosen on the storefront, the order stays out of the kitchen until the provider confirms it.
+type OnlinePayment struct {
+	AwaitingPaymentMethod PayMethod  `bun:"awaiting_payment_method" json:"awaiting_payment_method,omitempty"`
+	AwaitingPaymentAt     *time.Time `bun:"awaiting_payment_at" json:"awaiting_payment_at,omitempty"`
+}
+
+func (o *Order) AwaitPayment(method PayMethod) {
+	now := time.Now().UTC()
+	o.AwaitingPaymentMethod = method
+	o.AwaitingPaymentAt = &now
+}
+
+func (o *Order) IsAwaitingPayment() bool {
+	return o.AwaitingPaymentAt != nil
+}
+
+// ConfirmPayment pays the rest of the order with the awaited method, the order can then go to the kitchen.
+func (o *Order) ConfirmPayment() (*PaymentOrder, error) {
+	if !o.IsAwaitingPayment() {
+		return nil, ErrOrderNotAwaitingPayment
+	}
+
+	payment := NewPayment(o.TotalPayable-o.TotalPaid, o.AwaitingPaymentMethod, o.ID)
+	o.AddPayment(payment)
+	o.AwaitingPaymentMethod = ""
+	o.AwaitingPaymentAt = nil
+	return payment, nil
+}
@@ -0,0 +1,30 @@
+package orderentity
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	groupitementity "github.com/willjrcom/sales-backend-go/internal/domain/group_item"
+)
+
+func TestOnlinePayment(t *testing.T) {
+	order := NewDefaultOrder(nil, 1, nil)
+	order.Groups = []groupitementity.GroupItem{*groupitementity.NewGroupItem(groupitementity.GroupCommonAttributes{GroupDetails: groupitementity.GroupDetails{CategoryID: uuid.New()}})}
+	order.TotalPayable = 50
+
+	_, err := order.ConfirmPayment()
+	assert.EqualError(t, err, ErrOrderNotAwaitingPayment.Error())
+
+	order.AwaitPayment(Visa)
+	assert.True(t, order.IsAwaitingPayment())
+	assert.EqualError(t, order.PendingOrder(), ErrOrderAwaitingPayment.Error())
+
+	payment, err := order.ConfirmPayment()
+	assert.Nil(t, err)
+	assert.Equal(t, 50.0, payment.TotalPaid)
+	assert.Equal(t, Visa, payment.Method)
+	assert.Equal(t, 50.0, order.TotalPaid)
+	assert.False(t, order.IsAwaitingPayment())
+	assert.Nil(t, order.PendingOrder())
+}
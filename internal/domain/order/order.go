@@ -2,6 +2,7 @@ package orderentity
 
 import (
 	"errors"
+	"math"
 	"time"
 
 	"github.com/google/uuid"
@@ -22,6 +23,8 @@ var (
 	ErrOrderAlreadyArchived          = errors.New("order already archived")
 	ErrOrderPaidMoreThanTotal        = errors.New("order paid more than total")
 	ErrOrderPaidLessThanTotal        = errors.New("order paid less than total")
+	ErrOrderMustBeStagingOrPending   = errors.New("order must be staging or pending")
+	ErrOrderBelowMinOrderValue       = errors.New("order is below the min order value of the delivery zone")
 )
 
 type Order struct {
@@ -29,6 +32,7 @@ type Order struct {
 	bun.BaseModel `bun:"table:orders,alias:order"`
 	OrderTimeLogs
 	OrderCommonAttributes
+	Eta *OrderEta `bun:"-" json:"eta,omitempty"`
 }
 
 type OrderCommonAttributes struct {
@@ -42,9 +46,11 @@ type OrderCommonAttributes struct {
 
 type OrderDetail struct {
 	ScheduledOrder
+	OnlinePayment
 	TotalPayable  float64                  `bun:"total_payable" json:"total_payable"`
 	TotalPaid     float64                  `bun:"total_paid" json:"total_paid"`
 	TotalChange   float64                  `bun:"total_change" json:"total_change"`
+	Discount      float64                  `bun:"discount" json:"discount"`
 	QuantityItems float64                  `bun:"quantity_items" json:"quantity_items"`
 	Observation   string                   `bun:"observation" json:"observation"`
 	AttendantID   *uuid.UUID               `bun:"column:attendant_id,type:uuid,notnull" json:"attendant_id"`
@@ -104,10 +110,18 @@ func (o *Order) PendingOrder() (err error) {
 		return ErrOrderAlreadyArchived
 	}
 
+	if o.IsAwaitingPayment() {
+		return ErrOrderAwaitingPayment
+	}
+
 	if len(o.Groups) == 0 {
 		return ErrOrderWithoutItems
 	}
 
+	if o.Delivery != nil && o.ItemsTotal() < o.Delivery.MinOrderValue {
+		return ErrOrderBelowMinOrderValue
+	}
+
 	for i := range o.Groups {
 		if err = o.Groups[i].PendingGroupItem(); err != nil {
 			return err
@@ -155,6 +169,14 @@ func (o *Order) CancelOrder() (err error) {
 
 	for i := range o.Groups {
 		o.Groups[i].CancelGroupItem()
+
+		for j := range o.Groups[i].Items {
+			o.Groups[i].Items[j].CancelItem()
+
+			for k := range o.Groups[i].Items[j].AdditionalItems {
+				o.Groups[i].Items[j].AdditionalItems[k].CancelItem()
+			}
+		}
 	}
 
 	o.Status = OrderStatusCanceled
@@ -209,6 +231,29 @@ func (o *Order) AddPayment(payment *PaymentOrder) {
 	o.Payments = append(o.Payments, *payment)
 }
 
+// RefundPayments refunds the payments of the order, only the ones collected by the driver when it is given.
+// It returns the refunded payments.
+func (o *Order) RefundPayments(driverID *uuid.UUID) []PaymentOrder {
+	refunded := []PaymentOrder{}
+
+	for i := range o.Payments {
+		payment := &o.Payments[i]
+		if payment.RefundedAt != nil {
+			continue
+		}
+
+		if driverID != nil && (payment.DriverID == nil || *payment.DriverID != *driverID) {
+			continue
+		}
+
+		payment.Refund()
+		refunded = append(refunded, *payment)
+	}
+
+	o.CalculateTotalPrice()
+	return refunded
+}
+
 func (o *Order) CalculateTotalPrice() {
 	o.TotalPayable = 0.00
 	o.QuantityItems = 0.00
@@ -221,16 +266,42 @@ func (o *Order) CalculateTotalPrice() {
 
 	o.TotalPaid = 0.00
 	for _, payment := range o.Payments {
-		o.TotalPaid += payment.TotalPaid
+		if payment.RefundedAt == nil {
+			o.TotalPaid += payment.TotalPaid
+		}
 	}
 
 	if o.Delivery != nil && o.Delivery.DeliveryTax != nil {
 		o.TotalPayable += *o.Delivery.DeliveryTax
 	}
 
+	o.TotalPayable = math.Max(o.TotalPayable-o.Discount, 0)
+
 	if o.TotalPayable < o.TotalPaid {
 		o.TotalChange = o.TotalPaid - o.TotalPayable
 	} else {
 		o.TotalChange = 0
 	}
 }
+
+// ItemsTotal is the price of the items, without delivery tax and discount.
+func (o *Order) ItemsTotal() float64 {
+	total := 0.0
+	for i := range o.Groups {
+		o.Groups[i].CalculateTotalPrice()
+		total += o.Groups[i].TotalPrice
+	}
+
+	return total
+}
+
+// AddDiscount adds to the discount of the order, a negative value removes a discount already given.
+func (o *Order) AddDiscount(discount float64) error {
+	if o.Status != OrderStatusStaging && o.Status != OrderStatusPending {
+		return ErrOrderMustBeStagingOrPending
+	}
+
+	o.Discount = math.Max(o.Discount+discount, 0)
+	o.CalculateTotalPrice()
+	return nil
+}
@@ -19,10 +19,13 @@ type PaymentCommonAttributes struct {
 	TotalPaid float64   `bun:"total_paid" json:"total_paid"`
 	Method    PayMethod `bun:"method,notnull" json:"method"`
 	OrderID   uuid.UUID `bun:"column:order_id,type:uuid,notnull" json:"order_id"`
+	// DriverID is the driver who collected the payment on the delivery
+	DriverID *uuid.UUID `bun:"column:driver_id,type:uuid" json:"driver_id,omitempty"`
 }
 
 type PaymentTimeLogs struct {
-	PaidAt time.Time `bun:"paid_at" json:"paid_at,omitempty"`
+	PaidAt     time.Time  `bun:"paid_at" json:"paid_at,omitempty"`
+	RefundedAt *time.Time `bun:"refunded_at" json:"refunded_at,omitempty"`
 }
 
 func NewPayment(totalPaid float64, method PayMethod, orderID uuid.UUID) *PaymentOrder {
@@ -39,6 +42,16 @@ func NewPayment(totalPaid float64, method PayMethod, orderID uuid.UUID) *Payment
 	}
 }
 
+// Refund marks the payment as returned to the client, it is not counted on the total paid anymore.
+func (p *PaymentOrder) Refund() {
+	if p.RefundedAt != nil {
+		return
+	}
+
+	now := time.Now().UTC()
+	p.RefundedAt = &now
+}
+
 type PayMethod string
 
 // Tipos de cartão
@@ -59,6 +72,43 @@ const (
 	Outros          PayMethod = "Outros"
 )
 
+// PayMethodKind groups the pay methods by where the money goes.
+type PayMethodKind string
+
+const (
+	PayMethodKindCash    PayMethodKind = "cash"
+	PayMethodKindCard    PayMethodKind = "card"
+	PayMethodKindVoucher PayMethodKind = "voucher"
+	PayMethodKindOnline  PayMethodKind = "online"
+	PayMethodKindOther   PayMethodKind = "other"
+)
+
+var payMethodKinds = map[PayMethod]PayMethodKind{
+	Dinheiro:        PayMethodKindCash,
+	Visa:            PayMethodKindCard,
+	MasterCard:      PayMethodKindCard,
+	AmericanExpress: PayMethodKindCard,
+	Elo:             PayMethodKindCard,
+	DinersClub:      PayMethodKindCard,
+	Hipercard:       PayMethodKindCard,
+	VisaElectron:    PayMethodKindCard,
+	Maestro:         PayMethodKindCard,
+	Ticket:          PayMethodKindVoucher,
+	VR:              PayMethodKindVoucher,
+	Alelo:           PayMethodKindVoucher,
+	PayPal:          PayMethodKindOnline,
+	Outros:          PayMethodKindOther,
+}
+
+// Kind returns the group of the pay method, other for unknown methods.
+func (m PayMethod) Kind() PayMethodKind {
+	if kind, ok := payMethodKinds[m]; ok {
+		return kind
+	}
+
+	return PayMethodKindOther
+}
+
 func GetAllPayMethod() []PayMethod {
 	return []PayMethod{
 		Dinheiro,
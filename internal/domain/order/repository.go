@@ -1,6 +1,9 @@
 package orderentity
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type OrderRepository interface {
 	CreateOrder(ctx context.Context, order *Order) error
@@ -10,6 +13,8 @@ type OrderRepository interface {
 	GetOrderById(ctx context.Context, id string) (*Order, error)
 	GetAllOrders(ctx context.Context) ([]Order, error)
 	AddPaymentOrder(ctx context.Context, payment *PaymentOrder) error
+	UpdatePaymentOrders(ctx context.Context, payments []PaymentOrder) error
+	GetOrdersByClientId(ctx context.Context, clientID string) ([]Order, error)
 }
 
 type PickupOrderRepository interface {
@@ -26,6 +31,13 @@ type DeliveryOrderRepository interface {
 	DeleteDeliveryOrder(ctx context.Context, id string) error
 	GetDeliveryById(ctx context.Context, id string) (*DeliveryOrder, error)
 	GetAllDeliveries(ctx context.Context) ([]DeliveryOrder, error)
+	GetDeliveriesByClientId(ctx context.Context, clientID string) ([]DeliveryOrder, error)
+	GetDeliveriesByStatus(ctx context.Context, status StatusDeliveryOrder) ([]DeliveryOrder, error)
+	GetDeliveriesByDriverId(ctx context.Context, driverID string) ([]DeliveryOrder, error)
+	GetDeliveriesToDispatch(ctx context.Context) ([]DeliveryOrder, error)
+	UpdateDeliveryOrders(ctx context.Context, deliveries []DeliveryOrder) error
+	DeliverDeliveryOrder(ctx context.Context, delivery *DeliveryOrder, order *Order, payment *PaymentOrder) error
+	UpdateDeliveryWithRefunds(ctx context.Context, delivery *DeliveryOrder, order *Order, refunds []PaymentOrder) error
 }
 
 type TableOrderRepository interface {
@@ -34,4 +46,11 @@ type TableOrderRepository interface {
 	DeleteTableOrder(ctx context.Context, id string) error
 	GetTableOrderById(ctx context.Context, id string) (*TableOrder, error)
 	GetAllTableOrders(ctx context.Context) ([]TableOrder, error)
+	GetOpenTableOrderByTableID(ctx context.Context, tableID string) (*TableOrder, error)
+}
+
+type EtaRepository interface {
+	GetPreparationStats(ctx context.Context, since time.Time) ([]PreparationStats, error)
+	GetTravelStats(ctx context.Context, since time.Time) ([]TravelStats, error)
+	GetKitchenQueue(ctx context.Context) ([]time.Time, error)
 }
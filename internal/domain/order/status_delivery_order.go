@@ -1,17 +1,60 @@
 package orderentity
 
+import "time"
+
 type StatusDeliveryOrder string
 
 const (
-	DeliveryOrderStatusPending   StatusDeliveryOrder = "Pending"
-	DeliveryOrderStatusShipped   StatusDeliveryOrder = "Shipped"
-	DeliveryOrderStatusDelivered StatusDeliveryOrder = "Delivered"
+	DeliveryOrderStatusPending    StatusDeliveryOrder = "Pending"
+	DeliveryOrderStatusReady      StatusDeliveryOrder = "Ready"
+	DeliveryOrderStatusDispatched StatusDeliveryOrder = "Dispatched"
+	DeliveryOrderStatusDelivered  StatusDeliveryOrder = "Delivered"
+	DeliveryOrderStatusFailed     StatusDeliveryOrder = "Failed"
+	DeliveryOrderStatusReturned   StatusDeliveryOrder = "Returned"
+	DeliveryOrderStatusCanceled   StatusDeliveryOrder = "Canceled"
 )
 
+// deliveryTransitions are the next statuses allowed from each status,
+// failed and returned deliveries can be dispatched again.
+var deliveryTransitions = map[StatusDeliveryOrder][]StatusDeliveryOrder{
+	DeliveryOrderStatusPending:    {DeliveryOrderStatusReady, DeliveryOrderStatusDispatched, DeliveryOrderStatusCanceled},
+	DeliveryOrderStatusReady:      {DeliveryOrderStatusDispatched, DeliveryOrderStatusCanceled},
+	DeliveryOrderStatusDispatched: {DeliveryOrderStatusDelivered, DeliveryOrderStatusFailed},
+	DeliveryOrderStatusFailed:     {DeliveryOrderStatusReturned, DeliveryOrderStatusDispatched, DeliveryOrderStatusCanceled},
+	DeliveryOrderStatusReturned:   {DeliveryOrderStatusDispatched, DeliveryOrderStatusCanceled},
+}
+
+// DeliveryTransition is a change of status of the delivery, kept as history.
+type DeliveryTransition struct {
+	From      StatusDeliveryOrder `json:"from"`
+	To        StatusDeliveryOrder `json:"to"`
+	Reason    string              `json:"reason,omitempty"`
+	ChangedAt time.Time           `json:"changed_at"`
+}
+
 func GetAllDeliveryStatus() []StatusDeliveryOrder {
 	return []StatusDeliveryOrder{
 		DeliveryOrderStatusPending,
-		DeliveryOrderStatusShipped,
+		DeliveryOrderStatusReady,
+		DeliveryOrderStatusDispatched,
 		DeliveryOrderStatusDelivered,
+		DeliveryOrderStatusFailed,
+		DeliveryOrderStatusReturned,
+		DeliveryOrderStatusCanceled,
 	}
 }
+
+func (s StatusDeliveryOrder) CanTransitionTo(to StatusDeliveryOrder) bool {
+	for _, next := range deliveryTransitions[s] {
+		if next == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsClosed is true when the delivery can not change anymore.
+func (s StatusDeliveryOrder) IsClosed() bool {
+	return len(deliveryTransitions[s]) == 0
+}
@@ -1,12 +1,19 @@
 package orderentity
 
 import (
+	"errors"
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/uptrace/bun"
 	employeeentity "github.com/willjrcom/sales-backend-go/internal/domain/employee"
 	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
 )
 
+var (
+	ErrTableOrderNotAwaitingApproval = errors.New("table order is not awaiting approval")
+)
+
 type TableOrder struct {
 	entity.Entity
 	bun.BaseModel `bun:"table:table_orders"`
@@ -20,6 +27,13 @@ type TableOrderCommonAttributes struct {
 	Waiter   *employeeentity.Employee `bun:"rel:belongs-to" json:"waiter"`
 	OrderID  uuid.UUID                `bun:"column:order_id,type:uuid,notnull" json:"order_id"`
 	TableID  uuid.UUID                `bun:"column:table_id,type:uuid,notnull" json:"table_id"`
+	TableOrderSelfOrder
+}
+
+// TableOrderSelfOrder marks orders opened by customers on the table QR code.
+type TableOrderSelfOrder struct {
+	SelfOrder          bool       `bun:"self_order" json:"self_order"`
+	AwaitingApprovalAt *time.Time `bun:"awaiting_approval_at" json:"awaiting_approval_at,omitempty"`
 }
 
 func NewTable(tableOrderCommonAttributes TableOrderCommonAttributes) *TableOrder {
@@ -28,3 +42,18 @@ func NewTable(tableOrderCommonAttributes TableOrderCommonAttributes) *TableOrder
 		TableOrderCommonAttributes: tableOrderCommonAttributes,
 	}
 }
+
+// RequestApproval keeps the items sent by the customer waiting for the staff.
+func (t *TableOrder) RequestApproval() {
+	now := time.Now()
+	t.AwaitingApprovalAt = &now
+}
+
+func (t *TableOrder) Approve() error {
+	if t.AwaitingApprovalAt == nil {
+		return ErrTableOrderNotAwaitingApproval
+	}
+
+	t.AwaitingApprovalAt = nil
+	return nil
+}
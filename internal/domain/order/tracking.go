@@ -0,0 +1,71 @@
+package orderentity
+
+import (
+	"sort"
+	"time"
+)
+
+// DeliveryEstimatedTime is the time of the route of the driver, used when the zone has no estimated time nor past deliveries.
+var DeliveryEstimatedTime = 30 * time.Minute
+
+type TrackingStatus string
+
+const (
+	TrackingStatusReceived       TrackingStatus = "received"
+	TrackingStatusReady          TrackingStatus = "ready"
+	TrackingStatusOutForDelivery TrackingStatus = "out_for_delivery"
+	TrackingStatusDelivered      TrackingStatus = "delivered"
+	TrackingStatusPickedUp       TrackingStatus = "picked_up"
+	TrackingStatusFinished       TrackingStatus = "finished"
+	TrackingStatusCanceled       TrackingStatus = "canceled"
+)
+
+type TrackingStep struct {
+	Status TrackingStatus `json:"status"`
+	At     time.Time      `json:"at"`
+}
+
+// TrackingTimeline returns the steps already reached by the order, sorted by time.
+func (o *Order) TrackingTimeline() []TrackingStep {
+	steps := []TrackingStep{}
+
+	add := func(status TrackingStatus, at *time.Time) {
+		if at != nil {
+			steps = append(steps, TrackingStep{Status: status, At: *at})
+		}
+	}
+
+	add(TrackingStatusReceived, o.PendingAt)
+
+	if o.Delivery != nil {
+		add(TrackingStatusOutForDelivery, o.Delivery.LaunchedAt)
+		add(TrackingStatusDelivered, o.Delivery.DeliveredAt)
+	}
+
+	if o.Pickup != nil {
+		add(TrackingStatusReady, o.Pickup.ReadyAt)
+		add(TrackingStatusPickedUp, o.Pickup.PickedupAt)
+	}
+
+	add(TrackingStatusFinished, o.FinishedAt)
+	add(TrackingStatusCanceled, o.CanceledAt)
+
+	sort.SliceStable(steps, func(i, j int) bool {
+		return steps[i].At.Before(steps[j].At)
+	})
+
+	return steps
+}
+
+// IsTrackingDone is true when the customer already has the order or it was canceled.
+func (o *Order) IsTrackingDone() bool {
+	if o.Status == OrderStatusFinished || o.Status == OrderStatusCanceled || o.Status == OrderStatusArchived {
+		return true
+	}
+
+	if o.Delivery != nil && o.Delivery.DeliveredAt != nil {
+		return true
+	}
+
+	return o.Pickup != nil && o.Pickup.PickedupAt != nil
+}
@@ -0,0 +1,66 @@
+package orderentity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	groupitementity "github.com/willjrcom/sales-backend-go/internal/domain/group_item"
+)
+
+func TestTrackingDelivery(t *testing.T) {
+	pendingAt := time.Date(2024, 5, 10, 19, 0, 0, 0, time.UTC)
+	order := &Order{}
+	order.Status = OrderStatusPending
+	order.PendingAt = &pendingAt
+	order.Delivery = &DeliveryOrder{}
+	history := pendingGroup(order, 20*time.Minute)
+
+	assert.Equal(t, pendingAt.Add(20*time.Minute+DeliveryEstimatedTime), *order.Estimate(history, pendingAt).DeliveredAt)
+
+	launchedAt := pendingAt.Add(35 * time.Minute)
+	order.Delivery.LaunchedAt = &launchedAt
+	assert.Equal(t, launchedAt.Add(DeliveryEstimatedTime), *order.Estimate(history, launchedAt).DeliveredAt)
+
+	timeline := order.TrackingTimeline()
+	assert.Len(t, timeline, 2)
+	assert.Equal(t, TrackingStatusOutForDelivery, timeline[1].Status)
+	assert.False(t, order.IsTrackingDone())
+
+	deliveredAt := launchedAt.Add(15 * time.Minute)
+	order.Delivery.DeliveredAt = &deliveredAt
+	assert.True(t, order.IsTrackingDone())
+	assert.Nil(t, order.Estimate(history, deliveredAt))
+}
+
+func TestTrackingPickup(t *testing.T) {
+	order := &Order{}
+	order.Status = OrderStatusStaging
+	order.Pickup = &PickupOrder{}
+
+	assert.Empty(t, order.TrackingTimeline())
+
+	pendingAt := time.Date(2024, 5, 10, 19, 0, 0, 0, time.UTC)
+	history := pendingGroup(order, 10*time.Minute)
+	readyAt := pendingAt.Add(8 * time.Minute)
+	order.Status = OrderStatusPending
+	order.PendingAt = &pendingAt
+	order.Pickup.ReadyAt = &readyAt
+
+	assert.Equal(t, readyAt, *order.Estimate(history, pendingAt).ReadyAt)
+	assert.Equal(t, TrackingStatusReady, order.TrackingTimeline()[1].Status)
+}
+
+// pendingGroup adds a group sent to the kitchen with the order, the ideal time of its category is the preparation.
+func pendingGroup(order *Order, preparation time.Duration) *EtaHistory {
+	categoryID := uuid.New()
+
+	group := groupitementity.GroupItem{}
+	group.CategoryID = categoryID
+	group.Status = groupitementity.StatusGroupPending
+	group.PendingAt = order.PendingAt
+	order.Groups = append(order.Groups, group)
+
+	return &EtaHistory{IdealTimes: map[uuid.UUID]time.Duration{categoryID: preparation}}
+}
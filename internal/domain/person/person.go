@@ -57,6 +57,7 @@ func (p *Person) AddContact(contactInput *string, contactType ContactType) error
 
 func (p *Person) AddAddress(addressCommonAttributes *addressentity.AddressCommonAttributes) error {
 	addressCommonAttributes.ObjectID = p.ID
+	addressCommonAttributes.IsDefault = true
 	p.Address = addressentity.NewAddress(addressCommonAttributes)
 
 	if err := p.Address.Validate(); err != nil {
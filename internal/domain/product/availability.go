@@ -0,0 +1,156 @@
+package productentity
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrProductUnavailable       = errors.New("product is not available now")
+	ErrScheduleInvalidTime      = errors.New("schedule time must be in format HH:MM")
+	ErrScheduleInvalidWeekday   = errors.New("schedule weekday is invalid")
+	ErrScheduleInvalidDateRange = errors.New("schedule end date must be after start date")
+)
+
+const scheduleTimeLayout = "15:04"
+
+// AvailabilityWindow limits when a product or category can be ordered.
+// Empty fields don't restrict, an end time before the start time crosses midnight.
+type AvailabilityWindow struct {
+	Weekdays  []time.Weekday `json:"weekdays,omitempty"`
+	StartTime string         `json:"start_time,omitempty"`
+	EndTime   string         `json:"end_time,omitempty"`
+	StartDate *time.Time     `json:"start_date,omitempty"`
+	EndDate   *time.Time     `json:"end_date,omitempty"`
+}
+
+func (w *AvailabilityWindow) Validate() error {
+	for _, weekday := range w.Weekdays {
+		if weekday < time.Sunday || weekday > time.Saturday {
+			return ErrScheduleInvalidWeekday
+		}
+	}
+
+	for _, value := range []string{w.StartTime, w.EndTime} {
+		if value == "" {
+			continue
+		}
+
+		if _, err := time.Parse(scheduleTimeLayout, value); err != nil {
+			return ErrScheduleInvalidTime
+		}
+	}
+
+	if w.StartDate != nil && w.EndDate != nil && w.EndDate.Before(*w.StartDate) {
+		return ErrScheduleInvalidDateRange
+	}
+
+	return nil
+}
+
+// IsOpenAt must receive the time already in the company timezone.
+func (w *AvailabilityWindow) IsOpenAt(now time.Time) bool {
+	day := now
+
+	if w.StartTime != "" || w.EndTime != "" {
+		minute := now.Hour()*60 + now.Minute()
+		start, end := 0, 24*60
+
+		if w.StartTime != "" {
+			start = minuteOfDay(w.StartTime)
+		}
+
+		if w.EndTime != "" {
+			end = minuteOfDay(w.EndTime)
+		}
+
+		if start <= end {
+			if minute < start || minute >= end {
+				return false
+			}
+		} else {
+			if minute < start && minute >= end {
+				return false
+			}
+
+			// After midnight the window belongs to the day it started
+			if minute < end {
+				day = now.AddDate(0, 0, -1)
+			}
+		}
+	}
+
+	if len(w.Weekdays) != 0 && !containsWeekday(w.Weekdays, day.Weekday()) {
+		return false
+	}
+
+	date := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, time.UTC)
+
+	if w.StartDate != nil && date.Before(dateOnly(*w.StartDate)) {
+		return false
+	}
+
+	if w.EndDate != nil && date.After(dateOnly(*w.EndDate)) {
+		return false
+	}
+
+	return true
+}
+
+// ValidateSchedules validates all windows of a product or category.
+func ValidateSchedules(schedules []AvailabilityWindow) error {
+	for i := range schedules {
+		if err := schedules[i].Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// IsScheduledAt returns true without windows or when any window is open.
+func IsScheduledAt(schedules []AvailabilityWindow, now time.Time) bool {
+	if len(schedules) == 0 {
+		return true
+	}
+
+	for i := range schedules {
+		if schedules[i].IsOpenAt(now) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsAvailableAt checks the manual flag, the category schedules and the product schedules.
+func (p *Product) IsAvailableAt(now time.Time) bool {
+	if !p.IsAvailable {
+		return false
+	}
+
+	if p.Category != nil && !IsScheduledAt(p.Category.Schedules, now) {
+		return false
+	}
+
+	return IsScheduledAt(p.Schedules, now)
+}
+
+func minuteOfDay(value string) int {
+	t, _ := time.Parse(scheduleTimeLayout, value)
+	return t.Hour()*60 + t.Minute()
+}
+
+func dateOnly(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func containsWeekday(weekdays []time.Weekday, weekday time.Weekday) bool {
+	for _, w := range weekdays {
+		if w == weekday {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,46 @@
+package productentity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAvailabilityWindow(t *testing.T) {
+	breakfast := AvailabilityWindow{StartTime: "06:00", EndTime: "11:00"}
+	monday := time.Date(2024, time.January, 1, 7, 30, 0, 0, time.UTC)
+
+	assert.True(t, breakfast.IsOpenAt(monday))
+	assert.False(t, breakfast.IsOpenAt(monday.Add(4*time.Hour)))
+
+	// Overnight window on friday keeps open after midnight of saturday
+	night := AvailabilityWindow{Weekdays: []time.Weekday{time.Friday}, StartTime: "22:00", EndTime: "02:00"}
+	friday := time.Date(2024, time.January, 5, 23, 0, 0, 0, time.UTC)
+
+	assert.True(t, night.IsOpenAt(friday))
+	assert.True(t, night.IsOpenAt(friday.Add(2*time.Hour)))
+	assert.False(t, night.IsOpenAt(friday.Add(26*time.Hour)))
+
+	start := time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, time.July, 31, 0, 0, 0, 0, time.UTC)
+	seasonal := AvailabilityWindow{StartDate: &start, EndDate: &end}
+
+	assert.False(t, seasonal.IsOpenAt(monday))
+	assert.True(t, seasonal.IsOpenAt(time.Date(2024, time.July, 31, 20, 0, 0, 0, time.UTC)))
+
+	assert.EqualError(t, (&AvailabilityWindow{StartTime: "25:00"}).Validate(), ErrScheduleInvalidTime.Error())
+	assert.EqualError(t, (&AvailabilityWindow{StartDate: &end, EndDate: &start}).Validate(), ErrScheduleInvalidDateRange.Error())
+}
+
+func TestProductIsAvailableAt(t *testing.T) {
+	weekend := []AvailabilityWindow{{Weekdays: []time.Weekday{time.Saturday, time.Sunday}}}
+	product := &Product{ProductCommonAttributes: ProductCommonAttributes{IsAvailable: true, Category: &Category{CategoryCommonAttributes: CategoryCommonAttributes{Schedules: weekend}}}}
+	saturday := time.Date(2024, time.January, 6, 12, 0, 0, 0, time.UTC)
+
+	assert.True(t, product.IsAvailableAt(saturday))
+	assert.False(t, product.IsAvailableAt(saturday.AddDate(0, 0, 2)))
+
+	product.IsAvailable = false
+	assert.False(t, product.IsAvailableAt(saturday))
+}
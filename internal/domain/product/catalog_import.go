@@ -0,0 +1,26 @@
+package productentity
+
+// CatalogImport is every write of a catalog import, saved in one transaction.
+// Entities are pointers to the imported state, the last change of each one is saved.
+type CatalogImport struct {
+	NewCategories       []*Category
+	UpdatedCategories   []*Category
+	NewSizes            []*Size
+	UpdatedSizes        []*Size
+	NewQuantities       []*Quantity
+	NewProcessRules     []*ProcessRule
+	UpdatedProcessRules []*ProcessRule
+	NewProducts         []*Product
+	UpdatedProducts     []*Product
+	PriceChanges        []ProductPriceChange
+}
+
+// Categories returns the new and updated categories.
+func (c *CatalogImport) Categories() []*Category {
+	return append(append([]*Category{}, c.NewCategories...), c.UpdatedCategories...)
+}
+
+// Products returns the new and updated products.
+func (c *CatalogImport) Products() []*Product {
+	return append(append([]*Product{}, c.NewProducts...), c.UpdatedProducts...)
+}
@@ -0,0 +1,138 @@
+package productentity
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+)
+
+var (
+	ErrCloneNameRequired       = errors.New("clone name is required")
+	ErrCloneNameMustBeDistinct = errors.New("clone name must be different from the original")
+	ErrCloneCodeAffixRequired  = errors.New("code prefix or suffix is required to clone products")
+)
+
+// CloneCategoryOptions defines the new name of the category and how product codes stay unique.
+type CloneCategoryOptions struct {
+	Name         string `json:"name"`
+	CodePrefix   string `json:"code_prefix"`
+	CodeSuffix   string `json:"code_suffix"`
+	WithProducts bool   `json:"with_products"`
+}
+
+func (o *CloneCategoryOptions) Validate() error {
+	if o.Name == "" {
+		return ErrCloneNameRequired
+	}
+
+	if o.WithProducts && o.CodePrefix == "" && o.CodeSuffix == "" {
+		return ErrCloneCodeAffixRequired
+	}
+
+	return nil
+}
+
+// Clone copies the category with new ids for sizes, quantities, process rules and optionally products.
+// Additional categories are linked again, products keep their variants and combo slots pointing to the new sizes and products.
+func (c *Category) Clone(options CloneCategoryOptions) (*Category, error) {
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+
+	if options.Name == c.Name {
+		return nil, ErrCloneNameMustBeDistinct
+	}
+
+	clone := NewCategory(CategoryCommonAttributes{
+		Name:                 options.Name,
+		ImagePath:            c.ImagePath,
+		NeedPrint:            c.NeedPrint,
+		RemovableIngredients: append([]string{}, c.RemovableIngredients...),
+		AdditionalCategories: append([]Category{}, c.AdditionalCategories...),
+		Schedules:            append([]AvailabilityWindow{}, c.Schedules...),
+	})
+
+	sizeIDs := map[uuid.UUID]uuid.UUID{}
+	for _, size := range c.Sizes {
+		oldID := size.ID
+		size.Entity = entity.NewEntity()
+		size.CategoryID = clone.ID
+		size.Products = nil
+		sizeIDs[oldID] = size.ID
+		clone.Sizes = append(clone.Sizes, size)
+	}
+
+	for _, quantity := range c.Quantities {
+		quantity.Entity = entity.NewEntity()
+		quantity.CategoryID = clone.ID
+		clone.Quantities = append(clone.Quantities, quantity)
+	}
+
+	for _, processRule := range c.ProcessRules {
+		processRule.Entity = entity.NewEntity()
+		processRule.CategoryID = clone.ID
+		clone.ProcessRules = append(clone.ProcessRules, processRule)
+	}
+
+	if !options.WithProducts {
+		return clone, nil
+	}
+
+	productIDs := map[uuid.UUID]uuid.UUID{}
+	for _, product := range c.Products {
+		productIDs[product.ID] = uuid.New()
+	}
+
+	for _, product := range c.Products {
+		cloned := product
+		cloned.Entity = entity.NewEntity()
+		cloned.ID = productIDs[product.ID]
+		cloned.Code = options.CodePrefix + product.Code + options.CodeSuffix
+		cloned.CategoryID = clone.ID
+		cloned.Category = nil
+		cloned.Size = nil
+		cloned.SizeID = mapID(sizeIDs, product.SizeID)
+		cloned.Schedules = append([]AvailabilityWindow{}, product.Schedules...)
+
+		cloned.Variants = nil
+		for _, variant := range product.Variants {
+			variant.Entity = entity.NewEntity()
+			variant.ProductID = cloned.ID
+			variant.SizeID = mapID(sizeIDs, variant.SizeID)
+			variant.Size = nil
+			cloned.Variants = append(cloned.Variants, variant)
+		}
+
+		cloned.ComboSlots = nil
+		for _, slot := range product.ComboSlots {
+			slot.Entity = entity.NewEntity()
+			slot.ProductID = cloned.ID
+
+			if slot.CategoryID != nil && *slot.CategoryID == c.ID {
+				slot.CategoryID = &clone.ID
+			}
+
+			options := make([]ComboSlotOption, len(slot.Options))
+			for i, option := range slot.Options {
+				option.ProductID = mapID(productIDs, option.ProductID)
+				options[i] = option
+			}
+
+			slot.Options = options
+			cloned.ComboSlots = append(cloned.ComboSlots, slot)
+		}
+
+		clone.Products = append(clone.Products, cloned)
+	}
+
+	return clone, nil
+}
+
+func mapID(ids map[uuid.UUID]uuid.UUID, id uuid.UUID) uuid.UUID {
+	if newID, ok := ids[id]; ok {
+		return newID
+	}
+
+	return id
+}
@@ -0,0 +1,58 @@
+package productentity
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+)
+
+func TestCloneCategory(t *testing.T) {
+	category := NewCategory(CategoryCommonAttributes{Name: "Esfihas"})
+	small := Size{Entity: entity.NewEntity(), SizeCommonAttributes: SizeCommonAttributes{Name: "P", CategoryID: category.ID}}
+	category.Sizes = []Size{small}
+	category.Quantities = []Quantity{{Entity: entity.NewEntity(), QuantityCommonAttributes: QuantityCommonAttributes{Quantity: 1, CategoryID: category.ID}}}
+	category.ProcessRules = []ProcessRule{*NewProcessRule(ProcessRuleCommonAttributes{Name: "Forno", CategoryID: category.ID})}
+
+	product := Product{Entity: entity.NewEntity(), ProductCommonAttributes: ProductCommonAttributes{Code: "E1", CategoryID: category.ID, SizeID: small.ID, Price: 8}}
+	product.Variants = []ProductVariant{*NewProductVariant(ProductVariantCommonAttributes{SizeID: small.ID, Price: 8, ProductID: product.ID})}
+	category.Products = []Product{product}
+
+	clone, err := category.Clone(CloneCategoryOptions{Name: "Esfihas Doces", CodeSuffix: "-D", WithProducts: true})
+	assert.Nil(t, err)
+	assert.NotEqual(t, category.ID, clone.ID)
+	assert.Equal(t, "Esfihas Doces", clone.Name)
+
+	assert.Len(t, clone.Sizes, 1)
+	assert.NotEqual(t, small.ID, clone.Sizes[0].ID)
+	assert.Equal(t, clone.ID, clone.Sizes[0].CategoryID)
+	assert.Equal(t, clone.ID, clone.Quantities[0].CategoryID)
+	assert.Equal(t, clone.ID, clone.ProcessRules[0].CategoryID)
+
+	assert.Len(t, clone.Products, 1)
+	cloned := clone.Products[0]
+	assert.NotEqual(t, product.ID, cloned.ID)
+	assert.Equal(t, "E1-D", cloned.Code)
+	assert.Equal(t, clone.Sizes[0].ID, cloned.SizeID)
+	assert.Equal(t, clone.Sizes[0].ID, cloned.Variants[0].SizeID)
+	assert.Equal(t, cloned.ID, cloned.Variants[0].ProductID)
+
+	// original is untouched
+	assert.Equal(t, small.ID, category.Products[0].Variants[0].SizeID)
+}
+
+func TestCloneCategoryOptions(t *testing.T) {
+	category := NewCategory(CategoryCommonAttributes{Name: "Esfihas"})
+	category.Products = []Product{{Entity: entity.NewEntity(), ProductCommonAttributes: ProductCommonAttributes{Code: "E1", SizeID: uuid.New()}}}
+
+	_, err := category.Clone(CloneCategoryOptions{Name: "Esfihas"})
+	assert.EqualError(t, err, ErrCloneNameMustBeDistinct.Error())
+
+	_, err = category.Clone(CloneCategoryOptions{Name: "Copy", WithProducts: true})
+	assert.EqualError(t, err, ErrCloneCodeAffixRequired.Error())
+
+	clone, err := category.Clone(CloneCategoryOptions{Name: "Copy"})
+	assert.Nil(t, err)
+	assert.Empty(t, clone.Products)
+}
@@ -0,0 +1,174 @@
+package productentity
+
+import (
+	"errors"
+	"math"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+)
+
+var (
+	ErrProductIsNotCombo           = errors.New("product is not a combo")
+	ErrComboWithoutSlots           = errors.New("combo must have at least one slot")
+	ErrComboSlotNameRequired       = errors.New("combo slot name is required")
+	ErrComboSlotWithoutRule        = errors.New("combo slot must have a category or a product list")
+	ErrComboSlotNotFound           = errors.New("combo slot not found")
+	ErrComboSlotAlreadyFilled      = errors.New("combo slot already filled")
+	ErrComboSlotNotFilled          = errors.New("combo slot not filled")
+	ErrComboComponentNotAllowed    = errors.New("product not allowed in combo slot")
+	ErrComboComponentIsCombo       = errors.New("combo component can't be a combo")
+	ErrComboUpchargeMustBePositive = errors.New("combo upcharge must be positive")
+	ErrComboMustBeAddedAsCombo     = errors.New("combo must be added with its slots")
+)
+
+type ProductType string
+
+const (
+	ProductTypeSimple ProductType = "Simple"
+	ProductTypeCombo  ProductType = "Combo"
+)
+
+func GetAllProductTypes() []ProductType {
+	return []ProductType{
+		ProductTypeSimple,
+		ProductTypeCombo,
+	}
+}
+
+type ComboSlot struct {
+	entity.Entity
+	bun.BaseModel `bun:"table:combo_slots"`
+	ComboSlotCommonAttributes
+}
+
+type ComboSlotCommonAttributes struct {
+	Name       string            `bun:"name,notnull" json:"name"`
+	Order      int8              `bun:"order,notnull" json:"order"`
+	CategoryID *uuid.UUID        `bun:"column:category_id,type:uuid" json:"category_id,omitempty"`
+	Options    []ComboSlotOption `bun:"options,type:jsonb" json:"options,omitempty"`
+	ProductID  uuid.UUID         `bun:"column:product_id,type:uuid,notnull" json:"product_id"`
+}
+
+// ComboSlotOption restricts a slot to a product and defines its upcharge.
+// When a slot has a category, options only add upcharges to products of that category.
+type ComboSlotOption struct {
+	ProductID uuid.UUID `json:"product_id"`
+	Upcharge  float64   `json:"upcharge"`
+}
+
+func NewComboSlot(comboSlotCommonAttributes ComboSlotCommonAttributes) *ComboSlot {
+	return &ComboSlot{
+		Entity:                    entity.NewEntity(),
+		ComboSlotCommonAttributes: comboSlotCommonAttributes,
+	}
+}
+
+func (s *ComboSlot) Validate() error {
+	if s.Name == "" {
+		return ErrComboSlotNameRequired
+	}
+
+	if (s.CategoryID == nil || *s.CategoryID == uuid.Nil) && len(s.Options) == 0 {
+		return ErrComboSlotWithoutRule
+	}
+
+	for _, option := range s.Options {
+		if option.Upcharge < 0 {
+			return ErrComboUpchargeMustBePositive
+		}
+	}
+
+	return nil
+}
+
+// Upcharge validates if the product can fill the slot and returns its upcharge.
+func (s *ComboSlot) Upcharge(product *Product) (float64, error) {
+	if product.IsCombo() {
+		return 0, ErrComboComponentIsCombo
+	}
+
+	for _, option := range s.Options {
+		if option.ProductID == product.ID {
+			return option.Upcharge, nil
+		}
+	}
+
+	if s.CategoryID != nil && *s.CategoryID == product.CategoryID {
+		return 0, nil
+	}
+
+	return 0, ErrComboComponentNotAllowed
+}
+
+func (p *Product) IsCombo() bool {
+	return p.Type == ProductTypeCombo
+}
+
+func (p *Product) GetComboSlot(id uuid.UUID) (*ComboSlot, error) {
+	for i := range p.ComboSlots {
+		if p.ComboSlots[i].ID == id {
+			return &p.ComboSlots[i], nil
+		}
+	}
+
+	return nil, ErrComboSlotNotFound
+}
+
+func (p *Product) ValidateComboSlots() error {
+	if !p.IsCombo() {
+		return nil
+	}
+
+	if len(p.ComboSlots) == 0 {
+		return ErrComboWithoutSlots
+	}
+
+	for i := range p.ComboSlots {
+		p.ComboSlots[i].ProductID = p.ID
+
+		if err := p.ComboSlots[i].Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AllocateComboPrice splits the combo price across components proportionally to their list prices.
+// The last component absorbs the rounding difference so the sum always matches the combo price.
+func AllocateComboPrice(comboPrice float64, listPrices []float64) []float64 {
+	allocated := make([]float64, len(listPrices))
+
+	if len(listPrices) == 0 {
+		return allocated
+	}
+
+	totalListPrice := 0.0
+	for _, price := range listPrices {
+		totalListPrice += price
+	}
+
+	remaining := comboPrice
+	for i, price := range listPrices {
+		if i == len(listPrices)-1 {
+			allocated[i] = roundPrice(remaining)
+			break
+		}
+
+		share := comboPrice / float64(len(listPrices))
+		if totalListPrice > 0 {
+			share = comboPrice * price / totalListPrice
+		}
+
+		allocated[i] = roundPrice(share)
+		remaining -= allocated[i]
+	}
+
+	return allocated
+}
+
+func roundPrice(value float64) float64 {
+	return math.Round(value*100) / 100
+}
@@ -0,0 +1,49 @@
+package productentity
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+)
+
+func TestAllocateComboPrice(t *testing.T) {
+	allocated := AllocateComboPrice(30, []float64{20, 10, 10})
+
+	assert.Equal(t, []float64{15, 7.5, 7.5}, allocated)
+
+	// Rounding difference goes to the last component
+	allocated = AllocateComboPrice(10, []float64{1, 1, 1})
+
+	assert.Equal(t, []float64{3.33, 3.33, 3.34}, allocated)
+
+	// Without list prices the combo price is split equally
+	allocated = AllocateComboPrice(9, []float64{0, 0, 0})
+
+	assert.Equal(t, []float64{3, 3, 3}, allocated)
+}
+
+func TestComboSlotUpcharge(t *testing.T) {
+	drinks := uuid.New()
+	juice := &Product{Entity: entity.NewEntity(), ProductCommonAttributes: ProductCommonAttributes{CategoryID: drinks}}
+	soda := &Product{Entity: entity.NewEntity(), ProductCommonAttributes: ProductCommonAttributes{CategoryID: drinks}}
+	burger := &Product{Entity: entity.NewEntity(), ProductCommonAttributes: ProductCommonAttributes{CategoryID: uuid.New()}}
+
+	slot := NewComboSlot(ComboSlotCommonAttributes{
+		Name:       "Drink",
+		CategoryID: &drinks,
+		Options:    []ComboSlotOption{{ProductID: juice.ID, Upcharge: 2.5}},
+	})
+
+	upcharge, err := slot.Upcharge(juice)
+	assert.Nil(t, err)
+	assert.Equal(t, 2.5, upcharge)
+
+	upcharge, err = slot.Upcharge(soda)
+	assert.Nil(t, err)
+	assert.Equal(t, 0.0, upcharge)
+
+	_, err = slot.Upcharge(burger)
+	assert.EqualError(t, err, ErrComboComponentNotAllowed.Error())
+}
@@ -0,0 +1,110 @@
+package productentity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+)
+
+var (
+	ErrPriceChangeMustBeFuture     = errors.New("price change must be scheduled to the future")
+	ErrPriceChangeAlreadyApplied   = errors.New("price change already applied")
+	ErrPriceChangeProductNotMatch  = errors.New("price change is from another product")
+	ErrPriceChangeCostGreaterPrice = errors.New("price change cost must be less than price")
+)
+
+// ProductPriceChange records each price and cost change of a product.
+// Changes scheduled to the future are applied by a background job after EffectiveAt.
+type ProductPriceChange struct {
+	entity.Entity
+	bun.BaseModel `bun:"table:product_price_changes"`
+	ProductPriceChangeCommonAttributes
+}
+
+type ProductPriceChangeCommonAttributes struct {
+	ProductID   uuid.UUID  `bun:"column:product_id,type:uuid,notnull" json:"product_id"`
+	OldPrice    float64    `bun:"old_price" json:"old_price"`
+	OldCost     float64    `bun:"old_cost" json:"old_cost"`
+	Price       float64    `bun:"price,notnull" json:"price"`
+	Cost        float64    `bun:"cost" json:"cost"`
+	EffectiveAt time.Time  `bun:"effective_at,notnull" json:"effective_at"`
+	AppliedAt   *time.Time `bun:"applied_at" json:"applied_at,omitempty"`
+	UserID      *uuid.UUID `bun:"column:user_id,type:uuid" json:"user_id,omitempty"`
+}
+
+func NewProductPriceChange(productID uuid.UUID, price float64, cost float64, effectiveAt time.Time, userID *uuid.UUID) (*ProductPriceChange, error) {
+	if price < cost {
+		return nil, ErrPriceChangeCostGreaterPrice
+	}
+
+	return &ProductPriceChange{
+		Entity: entity.NewEntity(),
+		ProductPriceChangeCommonAttributes: ProductPriceChangeCommonAttributes{
+			ProductID:   productID,
+			Price:       price,
+			Cost:        cost,
+			EffectiveAt: effectiveAt,
+			UserID:      userID,
+		},
+	}, nil
+}
+
+// NewAppliedPriceChange records a change already made on the product.
+func NewAppliedPriceChange(product *Product, oldPrice float64, oldCost float64, userID *uuid.UUID) *ProductPriceChange {
+	now := time.Now().UTC()
+
+	return &ProductPriceChange{
+		Entity: entity.NewEntity(),
+		ProductPriceChangeCommonAttributes: ProductPriceChangeCommonAttributes{
+			ProductID:   product.ID,
+			OldPrice:    oldPrice,
+			OldCost:     oldCost,
+			Price:       product.Price,
+			Cost:        product.Cost,
+			EffectiveAt: now,
+			AppliedAt:   &now,
+			UserID:      userID,
+		},
+	}
+}
+
+func (c *ProductPriceChange) ValidateSchedule() error {
+	if !c.EffectiveAt.After(time.Now()) {
+		return ErrPriceChangeMustBeFuture
+	}
+
+	return nil
+}
+
+func (c *ProductPriceChange) IsApplied() bool {
+	return c.AppliedAt != nil
+}
+
+// Apply changes the product price and cost, the variant of the default size follows the product.
+func (c *ProductPriceChange) Apply(product *Product) error {
+	if c.IsApplied() {
+		return ErrPriceChangeAlreadyApplied
+	}
+
+	if c.ProductID != product.ID {
+		return ErrPriceChangeProductNotMatch
+	}
+
+	c.OldPrice = product.Price
+	c.OldCost = product.Cost
+
+	product.Price = c.Price
+	product.Cost = c.Cost
+
+	if variant, err := product.GetVariantBySize(product.SizeID); err == nil {
+		variant.Price = c.Price
+		variant.Cost = c.Cost
+	}
+
+	now := time.Now().UTC()
+	c.AppliedAt = &now
+	return nil
+}
@@ -0,0 +1,29 @@
+package productentity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+)
+
+func TestProductPriceChangeApply(t *testing.T) {
+	product := &Product{Entity: entity.NewEntity(), ProductCommonAttributes: ProductCommonAttributes{Price: 20, Cost: 8}}
+
+	change, err := NewProductPriceChange(product.ID, 25, 10, time.Now().Add(time.Hour), nil)
+	assert.Nil(t, err)
+	assert.Nil(t, change.ValidateSchedule())
+
+	assert.Nil(t, change.Apply(product))
+	assert.Equal(t, 25.0, product.Price)
+	assert.Equal(t, 10.0, product.Cost)
+	assert.Equal(t, 20.0, change.OldPrice)
+	assert.Equal(t, 8.0, change.OldCost)
+	assert.True(t, change.IsApplied())
+
+	assert.EqualError(t, change.Apply(product), ErrPriceChangeAlreadyApplied.Error())
+
+	past, _ := NewProductPriceChange(product.ID, 25, 10, time.Now().Add(-time.Hour), nil)
+	assert.EqualError(t, past.ValidateSchedule(), ErrPriceChangeMustBeFuture.Error())
+}
@@ -0,0 +1,253 @@
+package productentity
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+)
+
+var (
+	ErrPriceListNameRequired    = errors.New("price list name is required")
+	ErrPriceListChannelInvalid  = errors.New("price list channel is invalid")
+	ErrPriceRuleTargetRequired  = errors.New("price rule must have a product or a category")
+	ErrPriceRuleTargetDuplicate = errors.New("price rule must have only a product or a category")
+	ErrPriceRuleTypeInvalid     = errors.New("price rule type is invalid")
+	ErrPriceRuleValueInvalid    = errors.New("price rule value is invalid")
+	ErrPriceRuleNotFound        = errors.New("price rule not found")
+)
+
+type PriceChannel string
+
+const (
+	PriceChannelTable    PriceChannel = "table"
+	PriceChannelDelivery PriceChannel = "delivery"
+	PriceChannelPickup   PriceChannel = "pickup"
+)
+
+func GetAllPriceChannels() []PriceChannel {
+	return []PriceChannel{PriceChannelTable, PriceChannelDelivery, PriceChannelPickup}
+}
+
+type PriceRuleType string
+
+const (
+	PriceRuleTypeAbsolute   PriceRuleType = "absolute"
+	PriceRuleTypePercentage PriceRuleType = "percentage"
+)
+
+// PriceList groups price rules that apply on the channels and time windows of the list.
+// Lists with higher priority are evaluated first, without channels the list applies to all of them.
+type PriceList struct {
+	entity.Entity
+	bun.BaseModel `bun:"table:price_lists"`
+	PriceListCommonAttributes
+}
+
+type PriceListCommonAttributes struct {
+	Name      string               `bun:"name,notnull" json:"name"`
+	IsActive  bool                 `bun:"is_active" json:"is_active"`
+	Priority  int                  `bun:"priority" json:"priority"`
+	Channels  []PriceChannel       `bun:"channels,type:jsonb" json:"channels,omitempty"`
+	Schedules []AvailabilityWindow `bun:"schedules,type:jsonb" json:"schedules,omitempty"`
+	Rules     []PriceRule          `bun:"rel:has-many,join:id=price_list_id" json:"rules,omitempty"`
+}
+
+type PatchPriceList struct {
+	Name      *string              `json:"name"`
+	IsActive  *bool                `json:"is_active"`
+	Priority  *int                 `json:"priority"`
+	Channels  []PriceChannel       `json:"channels"`
+	Schedules []AvailabilityWindow `json:"schedules"`
+	Rules     []PriceRule          `json:"rules"`
+}
+
+// PriceRule overrides the price of a product or of all products of a category.
+// Absolute rules replace the price, percentage rules add the percentage to it (negative for discounts).
+type PriceRule struct {
+	entity.Entity
+	bun.BaseModel `bun:"table:price_rules"`
+	PriceRuleCommonAttributes
+}
+
+type PriceRuleCommonAttributes struct {
+	Type        PriceRuleType `bun:"type,notnull" json:"type"`
+	Value       float64       `bun:"value,notnull" json:"value"`
+	ProductID   *uuid.UUID    `bun:"column:product_id,type:uuid" json:"product_id,omitempty"`
+	CategoryID  *uuid.UUID    `bun:"column:category_id,type:uuid" json:"category_id,omitempty"`
+	PriceListID uuid.UUID     `bun:"column:price_list_id,type:uuid,notnull" json:"price_list_id"`
+}
+
+// AppliedPrice explains the price charged for a product.
+type AppliedPrice struct {
+	BasePrice   float64
+	Price       float64
+	PriceListID *uuid.UUID
+	PriceRuleID *uuid.UUID
+	Description string
+}
+
+func NewPriceList(priceListCommonAttributes PriceListCommonAttributes) *PriceList {
+	priceList := &PriceList{
+		Entity:                    entity.NewEntity(),
+		PriceListCommonAttributes: priceListCommonAttributes,
+	}
+
+	priceList.SetRules(priceListCommonAttributes.Rules)
+	return priceList
+}
+
+func NewPriceRule(priceRuleCommonAttributes PriceRuleCommonAttributes) *PriceRule {
+	return &PriceRule{
+		Entity:                    entity.NewEntity(),
+		PriceRuleCommonAttributes: priceRuleCommonAttributes,
+	}
+}
+
+// SetRules replaces the rules of the list creating new ids.
+func (l *PriceList) SetRules(rules []PriceRule) {
+	l.Rules = []PriceRule{}
+
+	for _, rule := range rules {
+		rule.PriceListID = l.ID
+		l.Rules = append(l.Rules, *NewPriceRule(rule.PriceRuleCommonAttributes))
+	}
+}
+
+func (l *PriceList) Validate() error {
+	if l.Name == "" {
+		return ErrPriceListNameRequired
+	}
+
+	for _, channel := range l.Channels {
+		if channel != PriceChannelTable && channel != PriceChannelDelivery && channel != PriceChannelPickup {
+			return ErrPriceListChannelInvalid
+		}
+	}
+
+	if err := ValidateSchedules(l.Schedules); err != nil {
+		return err
+	}
+
+	for i := range l.Rules {
+		if err := l.Rules[i].Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *PriceRule) Validate() error {
+	if r.ProductID == nil && r.CategoryID == nil {
+		return ErrPriceRuleTargetRequired
+	}
+
+	if r.ProductID != nil && r.CategoryID != nil {
+		return ErrPriceRuleTargetDuplicate
+	}
+
+	switch r.Type {
+	case PriceRuleTypeAbsolute:
+		if r.Value < 0 {
+			return ErrPriceRuleValueInvalid
+		}
+	case PriceRuleTypePercentage:
+		if r.Value <= -100 {
+			return ErrPriceRuleValueInvalid
+		}
+	default:
+		return ErrPriceRuleTypeInvalid
+	}
+
+	return nil
+}
+
+func (r *PriceRule) Apply(price float64) float64 {
+	if r.Type == PriceRuleTypeAbsolute {
+		return r.Value
+	}
+
+	return math.Round(price*(100+r.Value)) / 100
+}
+
+// IsActiveAt must receive the time already in the company timezone.
+func (l *PriceList) IsActiveAt(channel PriceChannel, now time.Time) bool {
+	if !l.IsActive {
+		return false
+	}
+
+	if len(l.Channels) != 0 {
+		found := false
+		for _, c := range l.Channels {
+			if c == channel {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return IsScheduledAt(l.Schedules, now)
+}
+
+// FindRule returns the product rule, or the category rule when the product has no rule.
+func (l *PriceList) FindRule(product *Product) (*PriceRule, error) {
+	var categoryRule *PriceRule
+
+	for i := range l.Rules {
+		rule := &l.Rules[i]
+
+		if rule.ProductID != nil && *rule.ProductID == product.ID {
+			return rule, nil
+		}
+
+		if rule.CategoryID != nil && *rule.CategoryID == product.CategoryID && categoryRule == nil {
+			categoryRule = rule
+		}
+	}
+
+	if categoryRule == nil {
+		return nil, ErrPriceRuleNotFound
+	}
+
+	return categoryRule, nil
+}
+
+// ResolvePrice applies the first matching rule of the active lists ordered by priority.
+// The product price must already be the price of the chosen size.
+func ResolvePrice(priceLists []PriceList, product *Product, channel PriceChannel, now time.Time) *AppliedPrice {
+	applied := &AppliedPrice{BasePrice: product.Price, Price: product.Price}
+
+	lists := make([]PriceList, len(priceLists))
+	copy(lists, priceLists)
+	sort.SliceStable(lists, func(i, j int) bool {
+		return lists[i].Priority > lists[j].Priority
+	})
+
+	for i := range lists {
+		if !lists[i].IsActiveAt(channel, now) {
+			continue
+		}
+
+		rule, err := lists[i].FindRule(product)
+		if err != nil {
+			continue
+		}
+
+		applied.Price = rule.Apply(product.Price)
+		applied.PriceListID = &lists[i].ID
+		applied.PriceRuleID = &rule.ID
+		applied.Description = lists[i].Name
+		return applied
+	}
+
+	return applied
+}
@@ -0,0 +1,48 @@
+package productentity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+)
+
+func TestResolvePrice(t *testing.T) {
+	drinks := uuid.New()
+	beer := &Product{Entity: entity.NewEntity(), ProductCommonAttributes: ProductCommonAttributes{CategoryID: drinks, Price: 10}}
+
+	delivery := NewPriceList(PriceListCommonAttributes{
+		Name:     "Delivery",
+		IsActive: true,
+		Channels: []PriceChannel{PriceChannelDelivery},
+		Rules:    []PriceRule{{PriceRuleCommonAttributes: PriceRuleCommonAttributes{Type: PriceRuleTypePercentage, Value: 10, CategoryID: &drinks}}},
+	})
+
+	happyHour := NewPriceList(PriceListCommonAttributes{
+		Name:      "Happy hour",
+		IsActive:  true,
+		Priority:  1,
+		Schedules: []AvailabilityWindow{{StartTime: "17:00", EndTime: "19:00"}},
+		Rules:     []PriceRule{{PriceRuleCommonAttributes: PriceRuleCommonAttributes{Type: PriceRuleTypeAbsolute, Value: 7, ProductID: &beer.ID}}},
+	})
+
+	lists := []PriceList{*delivery, *happyHour}
+	noon := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	applied := ResolvePrice(lists, beer, PriceChannelTable, noon)
+	assert.Equal(t, 10.0, applied.Price)
+	assert.Nil(t, applied.PriceRuleID)
+
+	applied = ResolvePrice(lists, beer, PriceChannelDelivery, noon)
+	assert.Equal(t, 11.0, applied.Price)
+	assert.Equal(t, delivery.ID, *applied.PriceListID)
+	assert.Equal(t, delivery.Rules[0].ID, *applied.PriceRuleID)
+
+	// Higher priority list wins
+	applied = ResolvePrice(lists, beer, PriceChannelDelivery, noon.Add(6*time.Hour))
+	assert.Equal(t, 7.0, applied.Price)
+	assert.Equal(t, 10.0, applied.BasePrice)
+	assert.Equal(t, "Happy hour", applied.Description)
+}
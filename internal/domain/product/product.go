@@ -20,29 +20,36 @@ type Product struct {
 }
 
 type ProductCommonAttributes struct {
-	Code        string    `bun:"code,unique,notnull" json:"code"`
-	Name        string    `bun:"name,notnull" json:"name"`
-	ImagePath   *string   `bun:"image_path" json:"image_path"`
-	Description string    `bun:"description" json:"description"`
-	Price       float64   `bun:"price,notnull" json:"price"`
-	Cost        float64   `bun:"cost" json:"cost"`
-	IsAvailable bool      `bun:"is_available" json:"is_available"`
-	CategoryID  uuid.UUID `bun:"column:category_id,type:uuid,notnull" json:"category_id"`
-	Category    *Category `bun:"rel:belongs-to" json:"category,omitempty"`
-	SizeID      uuid.UUID `bun:"column:size_id,type:uuid,notnull" json:"size_id"`
-	Size        *Size     `bun:"rel:belongs-to" json:"size,omitempty"`
+	Code        string               `bun:"code,unique,notnull" json:"code"`
+	Name        string               `bun:"name,notnull" json:"name"`
+	Type        ProductType          `bun:"type" json:"type"`
+	ImagePath   *string              `bun:"image_path" json:"image_path"`
+	Description string               `bun:"description" json:"description"`
+	Price       float64              `bun:"price,notnull" json:"price"`
+	Cost        float64              `bun:"cost" json:"cost"`
+	IsAvailable bool                 `bun:"is_available" json:"is_available"`
+	CategoryID  uuid.UUID            `bun:"column:category_id,type:uuid,notnull" json:"category_id"`
+	Category    *Category            `bun:"rel:belongs-to" json:"category,omitempty"`
+	SizeID      uuid.UUID            `bun:"column:size_id,type:uuid,notnull" json:"size_id"`
+	Size        *Size                `bun:"rel:belongs-to" json:"size,omitempty"`
+	ComboSlots  []ComboSlot          `bun:"rel:has-many,join:id=product_id" json:"combo_slots,omitempty"`
+	Variants    []ProductVariant     `bun:"rel:has-many,join:id=product_id" json:"variants,omitempty"`
+	Schedules   []AvailabilityWindow `bun:"schedules,type:jsonb" json:"schedules,omitempty"`
 }
 
 type PatchProduct struct {
-	Code        *string    `json:"code"`
-	Name        *string    `json:"name"`
-	ImagePath   *string    `json:"image_path"`
-	Description *string    `json:"description"`
-	Price       *float64   `json:"price"`
-	Cost        *float64   `json:"cost"`
-	IsAvailable *bool      `json:"is_available"`
-	CategoryID  *uuid.UUID `json:"category_id"`
-	SizeID      *uuid.UUID `json:"size_id"`
+	Code        *string              `json:"code"`
+	Name        *string              `json:"name"`
+	ImagePath   *string              `json:"image_path"`
+	Description *string              `json:"description"`
+	Price       *float64             `json:"price"`
+	Cost        *float64             `json:"cost"`
+	IsAvailable *bool                `json:"is_available"`
+	CategoryID  *uuid.UUID           `json:"category_id"`
+	SizeID      *uuid.UUID           `json:"size_id"`
+	ComboSlots  []ComboSlot          `json:"combo_slots"`
+	Variants    []ProductVariant     `json:"variants"`
+	Schedules   []AvailabilityWindow `json:"schedules"`
 }
 
 func (p *Product) FindSizeInCategory() (bool, error) {
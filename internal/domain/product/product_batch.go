@@ -0,0 +1,314 @@
+package productentity
+
+import (
+	"errors"
+	"math"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+)
+
+var (
+	ErrBatchWithoutProducts     = errors.New("no products match the filter")
+	ErrBatchTargetInvalid       = errors.New("target must be price or cost")
+	ErrBatchAdjustmentInvalid   = errors.New("adjustment type must be percentage or absolute")
+	ErrBatchRoundingInvalid     = errors.New("rounding is invalid")
+	ErrBatchValueInvalid        = errors.New("adjustment value is invalid")
+	ErrBatchNegativeValue       = errors.New("adjustment results in a negative value")
+	ErrBatchCostGreaterPrice    = errors.New("adjustment results in cost greater than price")
+	ErrBatchFilterRequired      = errors.New("at least one filter is required")
+	ErrBatchAvailabilityMissing = errors.New("is available is required")
+)
+
+type BatchType string
+
+const (
+	BatchTypePrice        BatchType = "price"
+	BatchTypeAvailability BatchType = "availability"
+)
+
+type AdjustmentTarget string
+
+const (
+	AdjustmentTargetPrice AdjustmentTarget = "price"
+	AdjustmentTargetCost  AdjustmentTarget = "cost"
+)
+
+type AdjustmentType string
+
+const (
+	AdjustmentTypePercentage AdjustmentType = "percentage"
+	AdjustmentTypeAbsolute   AdjustmentType = "absolute"
+)
+
+type Rounding string
+
+const (
+	RoundingCents    Rounding = "cents"
+	RoundingInteger  Rounding = "integer"
+	RoundingEnding90 Rounding = "ending_90"
+	RoundingEnding99 Rounding = "ending_99"
+)
+
+// ProductFilter selects the products of a bulk operation, empty fields don't filter.
+type ProductFilter struct {
+	CategoryID *uuid.UUID `json:"category_id,omitempty"`
+	SizeID     *uuid.UUID `json:"size_id,omitempty"`
+	CodePrefix string     `json:"code_prefix,omitempty"`
+}
+
+// PriceAdjustment adds a percentage or an absolute value to the price or to the cost.
+type PriceAdjustment struct {
+	Target   AdjustmentTarget `json:"target"`
+	Type     AdjustmentType   `json:"type"`
+	Value    float64          `json:"value"`
+	Rounding Rounding         `json:"rounding"`
+}
+
+// ProductBatch records a bulk operation and the before/after of each product changed.
+type ProductBatch struct {
+	entity.Entity
+	bun.BaseModel `bun:"table:product_batches"`
+	ProductBatchCommonAttributes
+}
+
+type ProductBatchCommonAttributes struct {
+	Type        BatchType            `bun:"type,notnull" json:"type"`
+	Filter      ProductFilter        `bun:"filter,type:jsonb" json:"filter"`
+	Adjustment  *PriceAdjustment     `bun:"adjustment,type:jsonb" json:"adjustment,omitempty"`
+	IsAvailable *bool                `bun:"is_available" json:"is_available,omitempty"`
+	Changes     []ProductBatchChange `bun:"changes,type:jsonb" json:"changes"`
+	UserID      *uuid.UUID           `bun:"column:user_id,type:uuid" json:"user_id,omitempty"`
+}
+
+type ProductBatchChange struct {
+	ProductID      uuid.UUID  `json:"product_id"`
+	VariantID      *uuid.UUID `json:"variant_id,omitempty"`
+	Code           string     `json:"code"`
+	Name           string     `json:"name"`
+	OldPrice       float64    `json:"old_price"`
+	NewPrice       float64    `json:"new_price"`
+	OldCost        float64    `json:"old_cost"`
+	NewCost        float64    `json:"new_cost"`
+	OldIsAvailable bool       `json:"old_is_available"`
+	NewIsAvailable bool       `json:"new_is_available"`
+}
+
+func (f *ProductFilter) Validate() error {
+	if f.CategoryID == nil && f.SizeID == nil && f.CodePrefix == "" {
+		return ErrBatchFilterRequired
+	}
+
+	return nil
+}
+
+// Match accepts products with the size as default size or as a variant.
+func (f *ProductFilter) Match(product *Product) bool {
+	if f.CategoryID != nil && product.CategoryID != *f.CategoryID {
+		return false
+	}
+
+	if f.CodePrefix != "" && !strings.HasPrefix(product.Code, f.CodePrefix) {
+		return false
+	}
+
+	if f.SizeID != nil && product.SizeID != *f.SizeID {
+		if _, err := product.GetVariantBySize(*f.SizeID); err != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (a *PriceAdjustment) Validate() error {
+	if a.Target != AdjustmentTargetPrice && a.Target != AdjustmentTargetCost {
+		return ErrBatchTargetInvalid
+	}
+
+	if a.Type != AdjustmentTypePercentage && a.Type != AdjustmentTypeAbsolute {
+		return ErrBatchAdjustmentInvalid
+	}
+
+	if a.Value == 0 || (a.Type == AdjustmentTypePercentage && a.Value <= -100) {
+		return ErrBatchValueInvalid
+	}
+
+	if a.Rounding == "" {
+		a.Rounding = RoundingCents
+	}
+
+	switch a.Rounding {
+	case RoundingCents, RoundingInteger, RoundingEnding90, RoundingEnding99:
+		return nil
+	default:
+		return ErrBatchRoundingInvalid
+	}
+}
+
+func (a *PriceAdjustment) Apply(value float64) float64 {
+	if a.Type == AdjustmentTypePercentage {
+		value = value * (100 + a.Value) / 100
+	} else {
+		value += a.Value
+	}
+
+	return RoundPrice(value, a.Rounding)
+}
+
+// RoundPrice rounds to cents, to the nearest integer or up to the next price ending in .90 or .99.
+func RoundPrice(value float64, rounding Rounding) float64 {
+	cents := math.Round(value*100) / 100
+
+	switch rounding {
+	case RoundingInteger:
+		return math.Round(value)
+	case RoundingEnding90:
+		return roundUpToEnding(cents, 0.9)
+	case RoundingEnding99:
+		return roundUpToEnding(cents, 0.99)
+	default:
+		return cents
+	}
+}
+
+func roundUpToEnding(value float64, ending float64) float64 {
+	rounded := math.Floor(value) + ending
+
+	if rounded < value {
+		rounded++
+	}
+
+	return math.Round(rounded*100) / 100
+}
+
+func NewProductBatch(batchType BatchType, filter ProductFilter, userID *uuid.UUID) *ProductBatch {
+	return &ProductBatch{
+		Entity: entity.NewEntity(),
+		ProductBatchCommonAttributes: ProductBatchCommonAttributes{
+			Type:    batchType,
+			Filter:  filter,
+			Changes: []ProductBatchChange{},
+			UserID:  userID,
+		},
+	}
+}
+
+// AdjustPrices changes the matching products and returns them, variants of the filtered size are changed too.
+func (b *ProductBatch) AdjustPrices(products []Product, adjustment PriceAdjustment) ([]Product, error) {
+	b.Adjustment = &adjustment
+	changed := []Product{}
+
+	for i := range products {
+		product := products[i]
+		if !b.Filter.Match(&product) {
+			continue
+		}
+
+		if product.HasVariants() {
+			variants := make([]ProductVariant, len(product.Variants))
+			copy(variants, product.Variants)
+			product.Variants = variants
+
+			for j := range product.Variants {
+				variant := &product.Variants[j]
+				if b.Filter.SizeID != nil && variant.SizeID != *b.Filter.SizeID {
+					continue
+				}
+
+				change := b.newChange(&product)
+				change.VariantID = &variant.ID
+
+				if err := adjust(&adjustment, &variant.Price, &variant.Cost, change); err != nil {
+					return nil, errors.New(product.Code + ": " + err.Error())
+				}
+
+				b.Changes = append(b.Changes, *change)
+
+				if variant.SizeID == product.SizeID {
+					product.Price = variant.Price
+					product.Cost = variant.Cost
+				}
+			}
+		} else {
+			change := b.newChange(&product)
+
+			if err := adjust(&adjustment, &product.Price, &product.Cost, change); err != nil {
+				return nil, errors.New(product.Code + ": " + err.Error())
+			}
+
+			b.Changes = append(b.Changes, *change)
+		}
+
+		changed = append(changed, product)
+	}
+
+	if len(changed) == 0 {
+		return nil, ErrBatchWithoutProducts
+	}
+
+	return changed, nil
+}
+
+// SetAvailability turns on or off the matching products and returns them.
+func (b *ProductBatch) SetAvailability(products []Product, isAvailable bool) ([]Product, error) {
+	b.IsAvailable = &isAvailable
+	changed := []Product{}
+
+	for i := range products {
+		product := products[i]
+		if !b.Filter.Match(&product) {
+			continue
+		}
+
+		change := b.newChange(&product)
+		product.IsAvailable = isAvailable
+		change.NewIsAvailable = isAvailable
+
+		b.Changes = append(b.Changes, *change)
+		changed = append(changed, product)
+	}
+
+	if len(changed) == 0 {
+		return nil, ErrBatchWithoutProducts
+	}
+
+	return changed, nil
+}
+
+func (b *ProductBatch) newChange(product *Product) *ProductBatchChange {
+	return &ProductBatchChange{
+		ProductID:      product.ID,
+		Code:           product.Code,
+		Name:           product.Name,
+		OldPrice:       product.Price,
+		NewPrice:       product.Price,
+		OldCost:        product.Cost,
+		NewCost:        product.Cost,
+		OldIsAvailable: product.IsAvailable,
+		NewIsAvailable: product.IsAvailable,
+	}
+}
+
+func adjust(adjustment *PriceAdjustment, price *float64, cost *float64, change *ProductBatchChange) error {
+	change.OldPrice, change.OldCost = *price, *cost
+
+	if adjustment.Target == AdjustmentTargetPrice {
+		*price = adjustment.Apply(*price)
+	} else {
+		*cost = adjustment.Apply(*cost)
+	}
+
+	if *price < 0 || *cost < 0 {
+		return ErrBatchNegativeValue
+	}
+
+	if *price < *cost {
+		return ErrBatchCostGreaterPrice
+	}
+
+	change.NewPrice, change.NewCost = *price, *cost
+	return nil
+}
@@ -0,0 +1,58 @@
+package productentity
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+)
+
+func TestRoundPrice(t *testing.T) {
+	assert.Equal(t, 10.57, RoundPrice(10.567, RoundingCents))
+	assert.Equal(t, 11.0, RoundPrice(10.567, RoundingInteger))
+	assert.Equal(t, 10.9, RoundPrice(10.567, RoundingEnding90))
+	assert.Equal(t, 11.9, RoundPrice(10.95, RoundingEnding90))
+	assert.Equal(t, 10.99, RoundPrice(10.567, RoundingEnding99))
+}
+
+func TestAdjustPricesByCategory(t *testing.T) {
+	category, small, large := uuid.New(), uuid.New(), uuid.New()
+	pizza := Product{Entity: entity.NewEntity(), ProductCommonAttributes: ProductCommonAttributes{Code: "P1", CategoryID: category, SizeID: small, Price: 30, Cost: 10}}
+	pizza.Variants = []ProductVariant{
+		*NewProductVariant(ProductVariantCommonAttributes{SizeID: small, Price: 30, Cost: 10}),
+		*NewProductVariant(ProductVariantCommonAttributes{SizeID: large, Price: 50, Cost: 20}),
+	}
+	drink := Product{Entity: entity.NewEntity(), ProductCommonAttributes: ProductCommonAttributes{Code: "D1", CategoryID: uuid.New(), SizeID: small, Price: 5}}
+
+	batch := NewProductBatch(BatchTypePrice, ProductFilter{CategoryID: &category}, nil)
+	adjustment := PriceAdjustment{Target: AdjustmentTargetPrice, Type: AdjustmentTypePercentage, Value: 10}
+	assert.Nil(t, adjustment.Validate())
+
+	changed, err := batch.AdjustPrices([]Product{pizza, drink}, adjustment)
+	assert.Nil(t, err)
+	assert.Len(t, changed, 1)
+	assert.Len(t, batch.Changes, 2)
+	assert.Equal(t, 33.0, changed[0].Price)
+	assert.Equal(t, 55.0, changed[0].Variants[1].Price)
+
+	// original products are not changed, so a preview is harmless
+	assert.Equal(t, 30.0, pizza.Variants[0].Price)
+
+	_, err = NewProductBatch(BatchTypePrice, ProductFilter{CodePrefix: "X"}, nil).AdjustPrices([]Product{pizza}, adjustment)
+	assert.EqualError(t, err, ErrBatchWithoutProducts.Error())
+}
+
+func TestSetAvailabilityBySize(t *testing.T) {
+	small, large := uuid.New(), uuid.New()
+	pizza := Product{Entity: entity.NewEntity(), ProductCommonAttributes: ProductCommonAttributes{SizeID: small, IsAvailable: true}}
+	pizza.Variants = []ProductVariant{*NewProductVariant(ProductVariantCommonAttributes{SizeID: large})}
+	drink := Product{Entity: entity.NewEntity(), ProductCommonAttributes: ProductCommonAttributes{SizeID: small, IsAvailable: true}}
+
+	batch := NewProductBatch(BatchTypeAvailability, ProductFilter{SizeID: &large}, nil)
+	changed, err := batch.SetAvailability([]Product{pizza, drink}, false)
+	assert.Nil(t, err)
+	assert.Len(t, changed, 1)
+	assert.False(t, changed[0].IsAvailable)
+	assert.True(t, batch.Changes[0].OldIsAvailable)
+}
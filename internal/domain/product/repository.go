@@ -2,15 +2,21 @@ package productentity
 
 import (
 	"context"
+	"time"
 )
 
 type ProductRepository interface {
 	RegisterProduct(ctx context.Context, p *Product) error
 	UpdateProduct(ctx context.Context, p *Product) error
+	UpdateProductWithPriceChange(ctx context.Context, p *Product, change *ProductPriceChange) error
 	DeleteProduct(ctx context.Context, id string) error
 	GetProductById(ctx context.Context, id string) (*Product, error)
 	GetProductByCode(ctx context.Context, code string) (*Product, error)
 	GetAllProducts(ctx context.Context) ([]Product, error)
+	MergeProducts(ctx context.Context, p *Product, mergedIDs []string) error
+	ApplyProductBatch(ctx context.Context, batch *ProductBatch, products []Product, priceChanges []ProductPriceChange) error
+	GetAllProductBatches(ctx context.Context) ([]ProductBatch, error)
+	ImportCatalog(ctx context.Context, catalog *CatalogImport) error
 }
 
 type CategoryRepository interface {
@@ -20,6 +26,7 @@ type CategoryRepository interface {
 	GetCategoryById(ctx context.Context, id string) (*Category, error)
 	GetCategoryByName(ctx context.Context, name string, withRelation bool) (*Category, error)
 	GetAllCategories(ctx context.Context) ([]Category, error)
+	CloneCategory(ctx context.Context, category *Category) error
 }
 
 type SizeRepository interface {
@@ -42,3 +49,19 @@ type ProcessRuleRepository interface {
 	DeleteProcessRule(ctx context.Context, id string) error
 	GetProcessRuleById(ctx context.Context, id string) (*ProcessRule, error)
 }
+
+type PriceListRepository interface {
+	RegisterPriceList(ctx context.Context, priceList *PriceList) error
+	UpdatePriceList(ctx context.Context, priceList *PriceList) error
+	DeletePriceList(ctx context.Context, id string) error
+	GetPriceListById(ctx context.Context, id string) (*PriceList, error)
+	GetAllPriceLists(ctx context.Context) ([]PriceList, error)
+	GetActivePriceLists(ctx context.Context) ([]PriceList, error)
+}
+
+type ProductPriceChangeRepository interface {
+	RegisterPriceChange(ctx context.Context, change *ProductPriceChange) error
+	UpdatePriceChange(ctx context.Context, change *ProductPriceChange) error
+	GetPriceChangesByProductId(ctx context.Context, productID string) ([]ProductPriceChange, error)
+	GetPendingPriceChanges(ctx context.Context, until time.Time) ([]ProductPriceChange, error)
+}
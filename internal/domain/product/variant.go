@@ -0,0 +1,194 @@
+package productentity
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+)
+
+var (
+	ErrVariantNotFound          = errors.New("variant not found for size")
+	ErrVariantSizeDuplicated    = errors.New("variant size duplicated")
+	ErrVariantSizeNotInCategory = errors.New("variant size not found in category")
+	ErrVariantSizeInactive      = errors.New("variant size is inactive")
+	ErrVariantCostGreaterPrice  = errors.New("variant cost must be less than price")
+	ErrMergeWithoutProducts     = errors.New("at least two products are required to merge")
+	ErrMergeCategoryNotMatch    = errors.New("products to merge must have the same category")
+)
+
+type ProductVariant struct {
+	entity.Entity
+	bun.BaseModel `bun:"table:product_variants"`
+	ProductVariantCommonAttributes
+}
+
+type ProductVariantCommonAttributes struct {
+	Price     float64   `bun:"price,notnull" json:"price"`
+	Cost      float64   `bun:"cost" json:"cost"`
+	SizeID    uuid.UUID `bun:"column:size_id,type:uuid,notnull" json:"size_id"`
+	Size      *Size     `bun:"rel:belongs-to" json:"size,omitempty"`
+	ProductID uuid.UUID `bun:"column:product_id,type:uuid,notnull" json:"product_id"`
+}
+
+func NewProductVariant(productVariantCommonAttributes ProductVariantCommonAttributes) *ProductVariant {
+	return &ProductVariant{
+		Entity:                         entity.NewEntity(),
+		ProductVariantCommonAttributes: productVariantCommonAttributes,
+	}
+}
+
+func (p *Product) HasVariants() bool {
+	return len(p.Variants) != 0
+}
+
+func (p *Product) GetVariantBySize(sizeID uuid.UUID) (*ProductVariant, error) {
+	for i := range p.Variants {
+		if p.Variants[i].SizeID == sizeID {
+			return &p.Variants[i], nil
+		}
+	}
+
+	return nil, ErrVariantNotFound
+}
+
+// ApplyVariant sets size, price and cost of the product from the variant of the chosen size.
+// Products without variants only accept their own size.
+func (p *Product) ApplyVariant(sizeID uuid.UUID) error {
+	if !p.HasVariants() {
+		if p.SizeID != sizeID {
+			return ErrVariantNotFound
+		}
+
+		return nil
+	}
+
+	variant, err := p.GetVariantBySize(sizeID)
+
+	if err != nil {
+		return err
+	}
+
+	p.SizeID = variant.SizeID
+	p.Price = variant.Price
+	p.Cost = variant.Cost
+
+	if variant.Size != nil {
+		p.Size = variant.Size
+	}
+
+	return nil
+}
+
+// ApplyVariantBySizeName is used when only the size name is known, like in group items.
+func (p *Product) ApplyVariantBySizeName(name string) error {
+	for _, variant := range p.Variants {
+		if variant.Size != nil && variant.Size.Name == name {
+			return p.ApplyVariant(variant.SizeID)
+		}
+	}
+
+	if p.Size != nil && p.Size.Name == name {
+		return nil
+	}
+
+	return ErrVariantNotFound
+}
+
+// ValidateVariants checks that each variant uses a distinct active size of the category.
+func (p *Product) ValidateVariants() error {
+	if p.Category == nil {
+		return ErrCategoryNotFound
+	}
+
+	sizes := map[uuid.UUID]bool{}
+
+	for i := range p.Variants {
+		variant := &p.Variants[i]
+		variant.ProductID = p.ID
+
+		if sizes[variant.SizeID] {
+			return ErrVariantSizeDuplicated
+		}
+
+		sizes[variant.SizeID] = true
+
+		if variant.Price < variant.Cost {
+			return ErrVariantCostGreaterPrice
+		}
+
+		found := false
+		for _, size := range p.Category.Sizes {
+			if size.ID != variant.SizeID {
+				continue
+			}
+
+			if size.Active != nil && !*size.Active {
+				return ErrVariantSizeInactive
+			}
+
+			found = true
+			break
+		}
+
+		if !found {
+			return ErrVariantSizeNotInCategory
+		}
+	}
+
+	return nil
+}
+
+// MergeAsVariants turns the products of the same category into variants of the product.
+// Each product becomes the variant of its own size, the product keeps its first size as default.
+func (p *Product) MergeAsVariants(products []Product) error {
+	if len(products) == 0 {
+		return ErrMergeWithoutProducts
+	}
+
+	if !p.HasVariants() {
+		p.Variants = []ProductVariant{*p.newVariantFromProduct(p)}
+	}
+
+	for i := range products {
+		if products[i].CategoryID != p.CategoryID {
+			return ErrMergeCategoryNotMatch
+		}
+
+		if _, err := p.GetVariantBySize(products[i].SizeID); err == nil {
+			return ErrVariantSizeDuplicated
+		}
+
+		if products[i].HasVariants() {
+			for _, variant := range products[i].Variants {
+				if _, err := p.GetVariantBySize(variant.SizeID); err == nil {
+					return ErrVariantSizeDuplicated
+				}
+
+				p.Variants = append(p.Variants, *NewProductVariant(ProductVariantCommonAttributes{
+					Price:     variant.Price,
+					Cost:      variant.Cost,
+					SizeID:    variant.SizeID,
+					ProductID: p.ID,
+				}))
+			}
+
+			continue
+		}
+
+		p.Variants = append(p.Variants, *p.newVariantFromProduct(&products[i]))
+	}
+
+	return nil
+}
+
+func (p *Product) newVariantFromProduct(product *Product) *ProductVariant {
+	return NewProductVariant(ProductVariantCommonAttributes{
+		Price:     product.Price,
+		Cost:      product.Cost,
+		SizeID:    product.SizeID,
+		Size:      product.Size,
+		ProductID: p.ID,
+	})
+}
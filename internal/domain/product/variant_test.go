@@ -0,0 +1,42 @@
+package productentity
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+)
+
+func TestApplyVariant(t *testing.T) {
+	small, large := uuid.New(), uuid.New()
+	product := &Product{Entity: entity.NewEntity(), ProductCommonAttributes: ProductCommonAttributes{SizeID: small, Price: 30}}
+	product.Variants = []ProductVariant{
+		*NewProductVariant(ProductVariantCommonAttributes{SizeID: small, Price: 30, Cost: 10}),
+		*NewProductVariant(ProductVariantCommonAttributes{SizeID: large, Price: 50, Cost: 20}),
+	}
+
+	assert.Nil(t, product.ApplyVariant(large))
+	assert.Equal(t, large, product.SizeID)
+	assert.Equal(t, 50.0, product.Price)
+	assert.Equal(t, 20.0, product.Cost)
+
+	assert.EqualError(t, product.ApplyVariant(uuid.New()), ErrVariantNotFound.Error())
+}
+
+func TestMergeAsVariants(t *testing.T) {
+	category, small, large := uuid.New(), uuid.New(), uuid.New()
+	pizzaSmall := &Product{Entity: entity.NewEntity(), ProductCommonAttributes: ProductCommonAttributes{CategoryID: category, SizeID: small, Price: 30}}
+	pizzaLarge := Product{Entity: entity.NewEntity(), ProductCommonAttributes: ProductCommonAttributes{CategoryID: category, SizeID: large, Price: 50}}
+
+	assert.Nil(t, pizzaSmall.MergeAsVariants([]Product{pizzaLarge}))
+	assert.Len(t, pizzaSmall.Variants, 2)
+	assert.Equal(t, pizzaSmall.ID, pizzaSmall.Variants[1].ProductID)
+	assert.Equal(t, 50.0, pizzaSmall.Variants[1].Price)
+
+	// Same size can't be merged twice
+	assert.EqualError(t, pizzaSmall.MergeAsVariants([]Product{pizzaLarge}), ErrVariantSizeDuplicated.Error())
+
+	other := Product{Entity: entity.NewEntity(), ProductCommonAttributes: ProductCommonAttributes{CategoryID: uuid.New(), SizeID: uuid.New()}}
+	assert.EqualError(t, pizzaSmall.MergeAsVariants([]Product{other}), ErrMergeCategoryNotMatch.Error())
+}
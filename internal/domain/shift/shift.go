@@ -20,13 +20,25 @@ type Shift struct {
 type ShiftCommonAttributes struct {
 	CurrentOrderNumber int                      `bun:"current_order_number,notnull" json:"current_order_number"`
 	Orders             []orderentity.Order      `bun:"rel:has-many,join:id=shift_id" json:"orders,omitempty"`
-	Redeems            []string                 `bun:"redeems,type:json" json:"redeems,omitempty"`
+	Redeems            []Redeem                 `bun:"redeems,type:json" json:"redeems,omitempty"`
 	StartChange        float32                  `bun:"start_change" json:"start_change"`
 	EndChange          *float32                 `bun:"end_change" json:"end_change,omitempty"`
 	AttendantID        *uuid.UUID               `bun:"column:attendant_id,type:uuid" json:"attendant_id"`
 	Attendant          *employeeentity.Employee `bun:"rel:belongs-to" json:"attendant"`
 }
 
+// Redeem is a loyalty reward redeemed on an order of the shift.
+type Redeem struct {
+	TransactionID uuid.UUID  `json:"transaction_id"`
+	ClientID      uuid.UUID  `json:"client_id"`
+	OrderID       uuid.UUID  `json:"order_id"`
+	RewardName    string     `json:"reward_name"`
+	Points        int        `json:"points"`
+	Discount      float64    `json:"discount"`
+	RedeemedAt    time.Time  `json:"redeemed_at"`
+	ReversedAt    *time.Time `json:"reversed_at,omitempty"`
+}
+
 type OrderTimeLogs struct {
 	OpenedAt *time.Time `bun:"opened_at" json:"opened_at,omitempty"`
 	ClosedAt *time.Time `bun:"finished_at" json:"finished_at,omitempty"`
@@ -52,3 +64,19 @@ func (s *Shift) IncrementCurrentOrder() {
 func (s *Shift) IsClosed() bool {
 	return s.EndChange != nil
 }
+
+func (s *Shift) AddRedeem(redeem Redeem) {
+	s.Redeems = append(s.Redeems, redeem)
+}
+
+// ReverseRedeem keeps the redeem on the shift, marked as reversed.
+func (s *Shift) ReverseRedeem(transactionID uuid.UUID, reversedAt time.Time) bool {
+	for i := range s.Redeems {
+		if s.Redeems[i].TransactionID == transactionID && s.Redeems[i].ReversedAt == nil {
+			s.Redeems[i].ReversedAt = &reversedAt
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,95 @@
+package storefrontentity
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+)
+
+var (
+	ErrCartEmpty            = errors.New("cart is empty")
+	ErrCartItemNotFound     = errors.New("cart item not found")
+	ErrCartProductRequired  = errors.New("product id is required")
+	ErrCartQuantityRequired = errors.New("quantity id is required")
+)
+
+// Cart keeps the items chosen by the customer until the checkout creates the order.
+type Cart struct {
+	entity.Entity
+	bun.BaseModel `bun:"table:carts"`
+	CartCommonAttributes
+}
+
+type CartCommonAttributes struct {
+	ClientID uuid.UUID  `bun:"column:client_id,type:uuid,notnull,unique" json:"client_id"`
+	Items    []CartItem `bun:"items,type:jsonb" json:"items"`
+}
+
+type CartItem struct {
+	ID          uuid.UUID        `json:"id"`
+	ProductID   uuid.UUID        `json:"product_id"`
+	QuantityID  uuid.UUID        `json:"quantity_id"`
+	SizeID      *uuid.UUID       `json:"size_id,omitempty"`
+	Observation string           `json:"observation,omitempty"`
+	Additionals []CartAdditional `json:"additionals,omitempty"`
+}
+
+type CartAdditional struct {
+	ProductID  uuid.UUID `json:"product_id"`
+	QuantityID uuid.UUID `json:"quantity_id"`
+}
+
+func NewCart(clientID uuid.UUID) *Cart {
+	return &Cart{
+		Entity: entity.NewEntity(),
+		CartCommonAttributes: CartCommonAttributes{
+			ClientID: clientID,
+			Items:    []CartItem{},
+		},
+	}
+}
+
+func (c *Cart) AddItem(item CartItem) (uuid.UUID, error) {
+	if item.ProductID == uuid.Nil {
+		return uuid.Nil, ErrCartProductRequired
+	}
+
+	if item.QuantityID == uuid.Nil {
+		return uuid.Nil, ErrCartQuantityRequired
+	}
+
+	for _, additional := range item.Additionals {
+		if additional.ProductID == uuid.Nil {
+			return uuid.Nil, ErrCartProductRequired
+		}
+
+		if additional.QuantityID == uuid.Nil {
+			return uuid.Nil, ErrCartQuantityRequired
+		}
+	}
+
+	item.ID = uuid.New()
+	c.Items = append(c.Items, item)
+	return item.ID, nil
+}
+
+func (c *Cart) RemoveItem(id uuid.UUID) error {
+	for i := range c.Items {
+		if c.Items[i].ID == id {
+			c.Items = append(c.Items[:i], c.Items[i+1:]...)
+			return nil
+		}
+	}
+
+	return ErrCartItemNotFound
+}
+
+func (c *Cart) Clear() {
+	c.Items = []CartItem{}
+}
+
+func (c *Cart) IsEmpty() bool {
+	return len(c.Items) == 0
+}
@@ -0,0 +1,31 @@
+package storefrontentity
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCartItems(t *testing.T) {
+	cart := NewCart(uuid.New())
+	assert.True(t, cart.IsEmpty())
+
+	_, err := cart.AddItem(CartItem{QuantityID: uuid.New()})
+	assert.EqualError(t, err, ErrCartProductRequired.Error())
+
+	_, err = cart.AddItem(CartItem{ProductID: uuid.New(), QuantityID: uuid.New(), Additionals: []CartAdditional{{ProductID: uuid.New()}}})
+	assert.EqualError(t, err, ErrCartQuantityRequired.Error())
+
+	id, err := cart.AddItem(CartItem{ProductID: uuid.New(), QuantityID: uuid.New()})
+	assert.Nil(t, err)
+	assert.Len(t, cart.Items, 1)
+
+	assert.EqualError(t, cart.RemoveItem(uuid.New()), ErrCartItemNotFound.Error())
+	assert.Nil(t, cart.RemoveItem(id))
+	assert.True(t, cart.IsEmpty())
+
+	_, _ = cart.AddItem(CartItem{ProductID: uuid.New(), QuantityID: uuid.New()})
+	cart.Clear()
+	assert.True(t, cart.IsEmpty())
+}
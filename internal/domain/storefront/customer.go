@@ -0,0 +1,28 @@
+package storefrontentity
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	ErrCustomerNotIdentified = errors.New("customer not identified")
+	ErrCustomerNotRegistered = errors.New("customer not registered")
+)
+
+type CustomerValue string
+
+// Customer is the phone verified by otp, it is stored on the context of storefront routes.
+type Customer struct {
+	Ddd    string `json:"ddd"`
+	Number string `json:"number"`
+}
+
+func GetCustomerFromContext(ctx context.Context) (*Customer, error) {
+	customer, ok := ctx.Value(CustomerValue("customer")).(Customer)
+	if !ok {
+		return nil, ErrCustomerNotIdentified
+	}
+
+	return &customer, nil
+}
@@ -0,0 +1,108 @@
+package storefrontentity
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+)
+
+var (
+	ErrOtpInvalid         = errors.New("otp code is invalid")
+	ErrOtpExpired         = errors.New("otp code expired")
+	ErrOtpAlreadyVerified = errors.New("otp code already verified")
+	ErrOtpTooManyAttempts = errors.New("otp code exceeded the attempts, request a new one")
+)
+
+const (
+	OtpLength      = 6
+	OtpTTL         = 5 * time.Minute
+	OtpMaxAttempts = 5
+)
+
+// OtpCode identifies a customer by the phone, only the hash of the code is stored.
+type OtpCode struct {
+	entity.Entity
+	bun.BaseModel `bun:"table:otp_codes"`
+	OtpCodeCommonAttributes
+}
+
+type OtpCodeCommonAttributes struct {
+	Ddd        string     `bun:"ddd,notnull" json:"ddd"`
+	Number     string     `bun:"number,notnull" json:"number"`
+	CodeHash   string     `bun:"code_hash,notnull" json:"-"`
+	ExpiresAt  time.Time  `bun:"expires_at,notnull" json:"expires_at"`
+	Attempts   int        `bun:"attempts" json:"attempts"`
+	VerifiedAt *time.Time `bun:"verified_at" json:"verified_at,omitempty"`
+}
+
+// NewOtpCode returns the otp and the plain code to be sent to the customer.
+func NewOtpCode(ddd string, number string, now time.Time) (*OtpCode, string, error) {
+	code, err := randomCode(OtpLength)
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	otp := &OtpCode{
+		Entity: entity.NewEntity(),
+		OtpCodeCommonAttributes: OtpCodeCommonAttributes{
+			Ddd:       ddd,
+			Number:    number,
+			ExpiresAt: now.Add(OtpTTL),
+		},
+	}
+
+	otp.CodeHash = otp.hash(code)
+	return otp, code, nil
+}
+
+// Verify counts the attempt, the otp must be saved even when the code is wrong.
+func (o *OtpCode) Verify(code string, now time.Time) error {
+	if o.VerifiedAt != nil {
+		return ErrOtpAlreadyVerified
+	}
+
+	if now.After(o.ExpiresAt) {
+		return ErrOtpExpired
+	}
+
+	if o.Attempts >= OtpMaxAttempts {
+		return ErrOtpTooManyAttempts
+	}
+
+	o.Attempts++
+
+	if o.hash(code) != o.CodeHash {
+		return ErrOtpInvalid
+	}
+
+	o.VerifiedAt = &now
+	return nil
+}
+
+func (o *OtpCode) hash(code string) string {
+	sum := sha256.Sum256([]byte(o.ID.String() + code))
+	return hex.EncodeToString(sum[:])
+}
+
+func randomCode(length int) (string, error) {
+	code := make([]byte, length)
+
+	for i := range code {
+		n, err := rand.Int(rand.Reader, big.NewInt(10))
+
+		if err != nil {
+			return "", err
+		}
+
+		code[i] = byte('0' + n.Int64())
+	}
+
+	return string(code), nil
+}
@@ -0,0 +1,41 @@
+package storefrontentity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifyOtpCode(t *testing.T) {
+	now := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+
+	otp, code, err := NewOtpCode("11", "963849111", now)
+	assert.Nil(t, err)
+	assert.Len(t, code, OtpLength)
+	assert.NotEqual(t, code, otp.CodeHash)
+
+	assert.EqualError(t, otp.Verify("wrong", now), ErrOtpInvalid.Error())
+	assert.Equal(t, 1, otp.Attempts)
+
+	assert.Nil(t, otp.Verify(code, now.Add(time.Minute)))
+	assert.NotNil(t, otp.VerifiedAt)
+	assert.EqualError(t, otp.Verify(code, now.Add(time.Minute)), ErrOtpAlreadyVerified.Error())
+}
+
+func TestVerifyOtpCodeExpiredAndAttempts(t *testing.T) {
+	now := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+
+	otp, code, err := NewOtpCode("11", "963849111", now)
+	assert.Nil(t, err)
+	assert.EqualError(t, otp.Verify(code, now.Add(OtpTTL+time.Second)), ErrOtpExpired.Error())
+
+	otp, code, err = NewOtpCode("11", "963849111", now)
+	assert.Nil(t, err)
+
+	for i := 0; i < OtpMaxAttempts; i++ {
+		assert.EqualError(t, otp.Verify("wrong", now), ErrOtpInvalid.Error())
+	}
+
+	assert.EqualError(t, otp.Verify(code, now), ErrOtpTooManyAttempts.Error())
+}
@@ -0,0 +1,16 @@
+package storefrontentity
+
+import (
+	"context"
+	"time"
+)
+
+type OtpRepository interface {
+	RegisterOtpCode(ctx context.Context, otp *OtpCode) error
+	VerifyLastOtpCode(ctx context.Context, ddd string, number string, code string, now time.Time) error
+}
+
+type CartRepository interface {
+	SaveCart(ctx context.Context, cart *Cart) error
+	GetCartByClientID(ctx context.Context, clientID string) (*Cart, error)
+}
@@ -0,0 +1,46 @@
+package tableentity
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrPublicTokenInvalid   = errors.New("table public token is invalid")
+	ErrSelfOrderModeInvalid = errors.New("self order mode is invalid")
+)
+
+// SelfOrderMode defines where items ordered by customers on the table QR code go.
+type SelfOrderMode string
+
+const (
+	SelfOrderModeApproval SelfOrderMode = "approval"
+	SelfOrderModeKitchen  SelfOrderMode = "kitchen"
+)
+
+func ValidateSelfOrderMode(mode SelfOrderMode) error {
+	if mode != SelfOrderModeApproval && mode != SelfOrderModeKitchen {
+		return ErrSelfOrderModeInvalid
+	}
+
+	return nil
+}
+
+// RotatePublicToken changes the nonce signed in the public token, invalidating printed QR codes.
+func (t *Table) RotatePublicToken() {
+	t.PublicTokenNonce = uuid.New().String()
+}
+
+func (t *Table) ValidatePublicTokenNonce(nonce string) error {
+	if t.PublicTokenNonce == "" || t.PublicTokenNonce != nonce {
+		return ErrPublicTokenInvalid
+	}
+
+	return nil
+}
+
+// SubmitsToKitchen is false by default, so customers orders wait for the staff approval.
+func (t *Table) SubmitsToKitchen() bool {
+	return t.SelfOrderMode == SelfOrderModeKitchen
+}
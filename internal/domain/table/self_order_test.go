@@ -0,0 +1,19 @@
+package tableentity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRotatePublicToken(t *testing.T) {
+	table := &Table{}
+	assert.EqualError(t, table.ValidatePublicTokenNonce(""), ErrPublicTokenInvalid.Error())
+
+	table.RotatePublicToken()
+	nonce := table.PublicTokenNonce
+	assert.Nil(t, table.ValidatePublicTokenNonce(nonce))
+
+	table.RotatePublicToken()
+	assert.EqualError(t, table.ValidatePublicTokenNonce(nonce), ErrPublicTokenInvalid.Error())
+}
@@ -13,9 +13,11 @@ type Table struct {
 }
 
 type TableCommonAttributes struct {
-	Name        string                   `bun:"name,notnull" json:"name"`
-	IsAvailable bool                     `bun:"is_available" json:"is_available"`
-	Orders      []orderentity.TableOrder `bun:"rel:has-many,join:id=table_id" json:"orders,omitempty"`
+	Name             string                   `bun:"name,notnull" json:"name"`
+	IsAvailable      bool                     `bun:"is_available" json:"is_available"`
+	SelfOrderMode    SelfOrderMode            `bun:"self_order_mode" json:"self_order_mode"`
+	PublicTokenNonce string                   `bun:"public_token_nonce" json:"-"`
+	Orders           []orderentity.TableOrder `bun:"rel:has-many,join:id=table_id" json:"orders,omitempty"`
 }
 
 func (t *Table) LockTable() {
@@ -0,0 +1,21 @@
+package addressdto
+
+import (
+	addressentity "github.com/willjrcom/sales-backend-go/internal/domain/address"
+	"github.com/willjrcom/sales-backend-go/internal/infra/service/cep"
+)
+
+// CepAddressOutput pre-fills the address form, the number is always typed by the user.
+type CepAddressOutput struct {
+	addressentity.AddressCommonAttributes
+}
+
+func (c *CepAddressOutput) FromModel(model *cep.Cep, deliveryTax float64) {
+	c.Street = model.Street
+	c.Complement = model.Complement
+	c.Neighborhood = model.Neighborhood
+	c.City = model.City
+	c.State = model.State
+	c.Cep = model.Cep
+	c.DeliveryTax = deliveryTax
+}
@@ -46,12 +46,33 @@ func (a *RegisterAddressInput) ToModel() (*addressentity.Address, error) {
 	addressCommonAttributes := addressentity.AddressCommonAttributes{
 		Street:       *a.Street,
 		Number:       *a.Number,
-		Complement:   *a.Complement,
-		Reference:    *a.Reference,
 		Neighborhood: *a.Neighborhood,
 		City:         *a.City,
 		State:        *a.State,
-		Cep:          *a.Cep,
+	}
+
+	// Optional fields
+	if a.Complement != nil {
+		addressCommonAttributes.Complement = *a.Complement
+	}
+	if a.Reference != nil {
+		addressCommonAttributes.Reference = *a.Reference
+	}
+	if a.Cep != nil {
+		addressCommonAttributes.Cep = *a.Cep
+	}
+	if a.DeliveryTax != nil {
+		addressCommonAttributes.DeliveryTax = *a.DeliveryTax
+	}
+	if a.Label != nil {
+		addressCommonAttributes.Label = *a.Label
+	}
+	if a.IsDefault != nil {
+		addressCommonAttributes.IsDefault = *a.IsDefault
+	}
+	if a.Latitude != nil && a.Longitude != nil {
+		addressCommonAttributes.Latitude = a.Latitude
+		addressCommonAttributes.Longitude = a.Longitude
 	}
 
 	return &addressentity.Address{
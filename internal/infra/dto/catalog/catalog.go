@@ -0,0 +1,164 @@
+package catalogdto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	productentity "github.com/willjrcom/sales-backend-go/internal/domain/product"
+)
+
+// Catalog is the full product tree of a company, references between entities use names and codes
+// so the same file can be imported on another company.
+type Catalog struct {
+	Categories []CatalogCategory `json:"categories"`
+	rows       map[string]string
+}
+
+type CatalogCategory struct {
+	Name                 string                             `json:"name"`
+	ImagePath            string                             `json:"image_path,omitempty"`
+	NeedPrint            bool                               `json:"need_print"`
+	RemovableIngredients []string                           `json:"removable_ingredients,omitempty"`
+	Schedules            []productentity.AvailabilityWindow `json:"schedules,omitempty"`
+	AdditionalCategories []string                           `json:"additional_categories,omitempty"`
+	Sizes                []CatalogSize                      `json:"sizes,omitempty"`
+	Quantities           []float64                          `json:"quantities,omitempty"`
+	ProcessRules         []CatalogProcessRule               `json:"process_rules,omitempty"`
+	Products             []CatalogProduct                   `json:"products,omitempty"`
+}
+
+type CatalogSize struct {
+	Name   string `json:"name"`
+	Active bool   `json:"active"`
+}
+
+type CatalogProcessRule struct {
+	Name              string        `json:"name"`
+	Order             int8          `json:"order"`
+	IdealTime         time.Duration `json:"ideal_time"`
+	ExperimentalError time.Duration `json:"experimental_error"`
+}
+
+type CatalogProduct struct {
+	Code        string                             `json:"code"`
+	Name        string                             `json:"name"`
+	Type        productentity.ProductType          `json:"type,omitempty"`
+	Description string                             `json:"description,omitempty"`
+	Size        string                             `json:"size"`
+	Price       float64                            `json:"price"`
+	Cost        float64                            `json:"cost"`
+	IsAvailable bool                               `json:"is_available"`
+	Schedules   []productentity.AvailabilityWindow `json:"schedules,omitempty"`
+	Variants    []CatalogVariant                   `json:"variants,omitempty"`
+	ComboSlots  []CatalogComboSlot                 `json:"combo_slots,omitempty"`
+}
+
+type CatalogVariant struct {
+	Size  string  `json:"size"`
+	Price float64 `json:"price"`
+	Cost  float64 `json:"cost"`
+}
+
+type CatalogComboSlot struct {
+	Name     string               `json:"name"`
+	Order    int8                 `json:"order"`
+	Category string               `json:"category,omitempty"`
+	Options  []CatalogComboOption `json:"options,omitempty"`
+}
+
+type CatalogComboOption struct {
+	ProductCode string  `json:"product_code"`
+	Upcharge    float64 `json:"upcharge"`
+}
+
+// FromModels builds the catalog from the categories and the products with their relations.
+func (c *Catalog) FromModels(categories []productentity.Category, products []productentity.Product) {
+	categoryNames := map[uuid.UUID]string{}
+	sizeNames := map[uuid.UUID]string{}
+	productCodes := map[uuid.UUID]string{}
+
+	for _, category := range categories {
+		categoryNames[category.ID] = category.Name
+
+		for _, size := range category.Sizes {
+			sizeNames[size.ID] = size.Name
+		}
+	}
+
+	for _, product := range products {
+		productCodes[product.ID] = product.Code
+	}
+
+	c.Categories = []CatalogCategory{}
+
+	for _, category := range categories {
+		catalogCategory := CatalogCategory{
+			Name:                 category.Name,
+			ImagePath:            category.ImagePath,
+			NeedPrint:            category.NeedPrint,
+			RemovableIngredients: category.RemovableIngredients,
+			Schedules:            category.Schedules,
+		}
+
+		for _, additional := range category.AdditionalCategories {
+			catalogCategory.AdditionalCategories = append(catalogCategory.AdditionalCategories, additional.Name)
+		}
+
+		for _, size := range category.Sizes {
+			catalogCategory.Sizes = append(catalogCategory.Sizes, CatalogSize{Name: size.Name, Active: size.Active == nil || *size.Active})
+		}
+
+		for _, quantity := range category.Quantities {
+			catalogCategory.Quantities = append(catalogCategory.Quantities, quantity.Quantity)
+		}
+
+		for _, rule := range category.ProcessRules {
+			catalogCategory.ProcessRules = append(catalogCategory.ProcessRules, CatalogProcessRule{
+				Name:              rule.Name,
+				Order:             rule.Order,
+				IdealTime:         rule.IdealTime,
+				ExperimentalError: rule.ExperimentalError,
+			})
+		}
+
+		for _, product := range products {
+			if product.CategoryID != category.ID {
+				continue
+			}
+
+			catalogProduct := CatalogProduct{
+				Code:        product.Code,
+				Name:        product.Name,
+				Type:        product.Type,
+				Description: product.Description,
+				Size:        sizeNames[product.SizeID],
+				Price:       product.Price,
+				Cost:        product.Cost,
+				IsAvailable: product.IsAvailable,
+				Schedules:   product.Schedules,
+			}
+
+			for _, variant := range product.Variants {
+				catalogProduct.Variants = append(catalogProduct.Variants, CatalogVariant{Size: sizeNames[variant.SizeID], Price: variant.Price, Cost: variant.Cost})
+			}
+
+			for _, slot := range product.ComboSlots {
+				catalogSlot := CatalogComboSlot{Name: slot.Name, Order: slot.Order}
+
+				if slot.CategoryID != nil {
+					catalogSlot.Category = categoryNames[*slot.CategoryID]
+				}
+
+				for _, option := range slot.Options {
+					catalogSlot.Options = append(catalogSlot.Options, CatalogComboOption{ProductCode: productCodes[option.ProductID], Upcharge: option.Upcharge})
+				}
+
+				catalogProduct.ComboSlots = append(catalogProduct.ComboSlots, catalogSlot)
+			}
+
+			catalogCategory.Products = append(catalogCategory.Products, catalogProduct)
+		}
+
+		c.Categories = append(c.Categories, catalogCategory)
+	}
+}
@@ -0,0 +1,347 @@
+package catalogdto
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	productentity "github.com/willjrcom/sales-backend-go/internal/domain/product"
+)
+
+var (
+	ErrCSVHeaderInvalid = errors.New("csv header must have the columns type and category")
+)
+
+// Each CSV row is one entity of the catalog identified by the type column.
+// Schedules are only supported on JSON files.
+const (
+	csvTypeCategory    = "category"
+	csvTypeSize        = "size"
+	csvTypeQuantity    = "quantity"
+	csvTypeProcessRule = "process_rule"
+	csvTypeAdditional  = "additional"
+	csvTypeProduct     = "product"
+	csvTypeVariant     = "variant"
+	csvTypeComboSlot   = "combo_slot"
+	csvTypeComboOption = "combo_option"
+)
+
+var csvHeader = []string{
+	"type", "category", "name", "code", "product_type", "size", "price", "cost", "description",
+	"is_available", "active", "need_print", "removable_ingredients", "quantity", "order",
+	"ideal_time", "experimental_error", "slot", "slot_category", "option_code", "upcharge",
+}
+
+type csvRow map[string]string
+
+func (r csvRow) values() []string {
+	values := make([]string, len(csvHeader))
+	for i, column := range csvHeader {
+		values[i] = r[column]
+	}
+
+	return values
+}
+
+// WriteCSV writes the catalog with one row per entity.
+func (c *Catalog) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write(csvHeader); err != nil {
+		return err
+	}
+
+	rows := []csvRow{}
+
+	for _, category := range c.Categories {
+		rows = append(rows, csvRow{
+			"type":                  csvTypeCategory,
+			"category":              category.Name,
+			"need_print":            strconv.FormatBool(category.NeedPrint),
+			"removable_ingredients": strings.Join(category.RemovableIngredients, "|"),
+		})
+
+		for _, size := range category.Sizes {
+			rows = append(rows, csvRow{"type": csvTypeSize, "category": category.Name, "name": size.Name, "active": strconv.FormatBool(size.Active)})
+		}
+
+		for _, quantity := range category.Quantities {
+			rows = append(rows, csvRow{"type": csvTypeQuantity, "category": category.Name, "quantity": formatFloat(quantity)})
+		}
+
+		for _, rule := range category.ProcessRules {
+			rows = append(rows, csvRow{
+				"type":               csvTypeProcessRule,
+				"category":           category.Name,
+				"name":               rule.Name,
+				"order":              strconv.Itoa(int(rule.Order)),
+				"ideal_time":         rule.IdealTime.String(),
+				"experimental_error": rule.ExperimentalError.String(),
+			})
+		}
+
+		for _, additional := range category.AdditionalCategories {
+			rows = append(rows, csvRow{"type": csvTypeAdditional, "category": category.Name, "name": additional})
+		}
+
+		for _, product := range category.Products {
+			rows = append(rows, csvRow{
+				"type":         csvTypeProduct,
+				"category":     category.Name,
+				"code":         product.Code,
+				"name":         product.Name,
+				"product_type": string(product.Type),
+				"size":         product.Size,
+				"price":        formatFloat(product.Price),
+				"cost":         formatFloat(product.Cost),
+				"description":  product.Description,
+				"is_available": strconv.FormatBool(product.IsAvailable),
+			})
+
+			for _, variant := range product.Variants {
+				rows = append(rows, csvRow{"type": csvTypeVariant, "category": category.Name, "code": product.Code, "size": variant.Size, "price": formatFloat(variant.Price), "cost": formatFloat(variant.Cost)})
+			}
+
+			for _, slot := range product.ComboSlots {
+				rows = append(rows, csvRow{"type": csvTypeComboSlot, "category": category.Name, "code": product.Code, "slot": slot.Name, "order": strconv.Itoa(int(slot.Order)), "slot_category": slot.Category})
+
+				for _, option := range slot.Options {
+					rows = append(rows, csvRow{"type": csvTypeComboOption, "category": category.Name, "code": product.Code, "slot": slot.Name, "option_code": option.ProductCode, "upcharge": formatFloat(option.Upcharge)})
+				}
+			}
+		}
+	}
+
+	for _, row := range rows {
+		if err := writer.Write(row.values()); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// ReadCSV builds the catalog from the CSV file, parse errors are added to the report with the line number.
+func ReadCSV(r io.Reader, report *ImportReport) (*Catalog, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	columns := map[string]int{}
+	for i, column := range header {
+		columns[strings.TrimSpace(strings.ToLower(column))] = i
+	}
+
+	if _, ok := columns["type"]; !ok {
+		return nil, ErrCSVHeaderInvalid
+	}
+
+	if _, ok := columns["category"]; !ok {
+		return nil, ErrCSVHeaderInvalid
+	}
+
+	c := &Catalog{Categories: []CatalogCategory{}, rows: map[string]string{}}
+	line := 1
+
+	for {
+		record, err := reader.Read()
+		line++
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		row := csvRow{}
+		for column, i := range columns {
+			if i < len(record) {
+				row[column] = strings.TrimSpace(record[i])
+			}
+		}
+
+		c.readRow(row, fmt.Sprintf("line %d", line), report)
+	}
+
+	return c, nil
+}
+
+func (c *Catalog) readRow(row csvRow, line string, report *ImportReport) {
+	parser := &csvParser{row: row, line: line, report: report}
+	categoryIndex := c.categoryIndex(row["category"], line)
+	category := &c.Categories[categoryIndex]
+	path := fmt.Sprintf("categories[%d]", categoryIndex)
+
+	switch row["type"] {
+	case csvTypeCategory:
+		category.NeedPrint = parser.bool("need_print", false)
+
+		if row["removable_ingredients"] != "" {
+			category.RemovableIngredients = strings.Split(row["removable_ingredients"], "|")
+		}
+
+		c.rows[path] = line
+	case csvTypeSize:
+		c.rows[fmt.Sprintf("%s.sizes[%d]", path, len(category.Sizes))] = line
+		category.Sizes = append(category.Sizes, CatalogSize{Name: row["name"], Active: parser.bool("active", true)})
+	case csvTypeQuantity:
+		c.rows[fmt.Sprintf("%s.quantities[%d]", path, len(category.Quantities))] = line
+		category.Quantities = append(category.Quantities, parser.float("quantity"))
+	case csvTypeProcessRule:
+		c.rows[fmt.Sprintf("%s.process_rules[%d]", path, len(category.ProcessRules))] = line
+		category.ProcessRules = append(category.ProcessRules, CatalogProcessRule{
+			Name:              row["name"],
+			Order:             int8(parser.int("order")),
+			IdealTime:         parser.duration("ideal_time"),
+			ExperimentalError: parser.duration("experimental_error"),
+		})
+	case csvTypeAdditional:
+		c.rows[fmt.Sprintf("%s.additional_categories[%d]", path, len(category.AdditionalCategories))] = line
+		category.AdditionalCategories = append(category.AdditionalCategories, row["name"])
+	case csvTypeProduct:
+		c.rows[fmt.Sprintf("%s.products[%d]", path, len(category.Products))] = line
+		category.Products = append(category.Products, CatalogProduct{
+			Code:        row["code"],
+			Name:        row["name"],
+			Type:        productentity.ProductType(row["product_type"]),
+			Description: row["description"],
+			Size:        row["size"],
+			Price:       parser.float("price"),
+			Cost:        parser.float("cost"),
+			IsAvailable: parser.bool("is_available", true),
+		})
+	case csvTypeVariant, csvTypeComboSlot, csvTypeComboOption:
+		c.readProductRow(row, line, parser)
+	default:
+		report.AddError(line, "type "+row["type"]+" is invalid")
+	}
+}
+
+func (c *Catalog) readProductRow(row csvRow, line string, parser *csvParser) {
+	product, path := c.findProduct(row["code"])
+
+	if product == nil {
+		parser.report.AddError(line, "product "+row["code"]+" must be declared before")
+		return
+	}
+
+	switch row["type"] {
+	case csvTypeVariant:
+		c.rows[fmt.Sprintf("%s.variants[%d]", path, len(product.Variants))] = line
+		product.Variants = append(product.Variants, CatalogVariant{Size: row["size"], Price: parser.float("price"), Cost: parser.float("cost")})
+	case csvTypeComboSlot:
+		c.rows[fmt.Sprintf("%s.combo_slots[%d]", path, len(product.ComboSlots))] = line
+		product.ComboSlots = append(product.ComboSlots, CatalogComboSlot{Name: row["slot"], Order: int8(parser.int("order")), Category: row["slot_category"]})
+	case csvTypeComboOption:
+		for i := range product.ComboSlots {
+			slot := &product.ComboSlots[i]
+			if slot.Name != row["slot"] {
+				continue
+			}
+
+			c.rows[fmt.Sprintf("%s.combo_slots[%d].options[%d]", path, i, len(slot.Options))] = line
+			slot.Options = append(slot.Options, CatalogComboOption{ProductCode: row["option_code"], Upcharge: parser.float("upcharge")})
+			return
+		}
+
+		parser.report.AddError(line, "combo slot "+row["slot"]+" must be declared before")
+	}
+}
+
+func (c *Catalog) categoryIndex(name string, line string) int {
+	for i := range c.Categories {
+		if c.Categories[i].Name == name {
+			return i
+		}
+	}
+
+	c.Categories = append(c.Categories, CatalogCategory{Name: name})
+	index := len(c.Categories) - 1
+	c.rows[fmt.Sprintf("categories[%d]", index)] = line
+	return index
+}
+
+func (c *Catalog) findProduct(code string) (*CatalogProduct, string) {
+	for i := range c.Categories {
+		for j := range c.Categories[i].Products {
+			if c.Categories[i].Products[j].Code == code {
+				return &c.Categories[i].Products[j], fmt.Sprintf("categories[%d].products[%d]", i, j)
+			}
+		}
+	}
+
+	return nil, ""
+}
+
+type csvParser struct {
+	row    csvRow
+	line   string
+	report *ImportReport
+}
+
+func (p *csvParser) float(column string) float64 {
+	if p.row[column] == "" {
+		return 0
+	}
+
+	value, err := strconv.ParseFloat(strings.ReplaceAll(p.row[column], ",", "."), 64)
+	if err != nil {
+		p.report.AddError(p.line, column+" must be a number")
+	}
+
+	return value
+}
+
+func (p *csvParser) int(column string) int {
+	if p.row[column] == "" {
+		return 0
+	}
+
+	value, err := strconv.Atoi(p.row[column])
+	if err != nil {
+		p.report.AddError(p.line, column+" must be an integer")
+	}
+
+	return value
+}
+
+func (p *csvParser) bool(column string, defaultValue bool) bool {
+	if p.row[column] == "" {
+		return defaultValue
+	}
+
+	value, err := strconv.ParseBool(p.row[column])
+	if err != nil {
+		p.report.AddError(p.line, column+" must be true or false")
+	}
+
+	return value
+}
+
+func (p *csvParser) duration(column string) time.Duration {
+	if p.row[column] == "" {
+		return 0
+	}
+
+	value, err := time.ParseDuration(p.row[column])
+	if err != nil {
+		p.report.AddError(p.line, column+" must be a duration like 10m")
+	}
+
+	return value
+}
+
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
@@ -0,0 +1,71 @@
+package catalogdto
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	productentity "github.com/willjrcom/sales-backend-go/internal/domain/product"
+)
+
+func TestCatalogCSV(t *testing.T) {
+	catalog := &Catalog{Categories: []CatalogCategory{
+		{
+			Name:                 "Pizza",
+			NeedPrint:            true,
+			AdditionalCategories: []string{"Drinks"},
+			Sizes:                []CatalogSize{{Name: "P", Active: true}, {Name: "G", Active: true}},
+			Quantities:           []float64{0.5, 1},
+			Products: []CatalogProduct{{
+				Code: "PZ1", Name: "Calabresa", Type: productentity.ProductTypeSimple, Size: "P", Price: 30, Cost: 10, IsAvailable: true,
+				Variants: []CatalogVariant{{Size: "P", Price: 30, Cost: 10}, {Size: "G", Price: 50, Cost: 20}},
+			}},
+		},
+		{
+			Name:     "Drinks",
+			Sizes:    []CatalogSize{{Name: "Lata", Active: true}},
+			Products: []CatalogProduct{{Code: "CK", Name: "Coke", Size: "Lata", Price: 6, IsAvailable: true}},
+		},
+	}}
+
+	buffer := &bytes.Buffer{}
+	assert.Nil(t, catalog.WriteCSV(buffer))
+
+	report := NewImportReport(true)
+	read, err := ReadCSV(buffer, report)
+	assert.Nil(t, err)
+	assert.False(t, report.HasErrors())
+
+	read.rows = nil
+	assert.Equal(t, catalog, read)
+}
+
+func TestCatalogValidateReportsCSVLines(t *testing.T) {
+	file := "type,category,name,code,size,price,cost\n" +
+		"size,Pizza,P,,,,\n" +
+		"product,Pizza,Calabresa,PZ1,G,30,10\n" +
+		"product,Pizza,Mussarela,PZ1,P,10,30\n"
+
+	report := NewImportReport(true)
+	catalog, err := ReadCSV(strings.NewReader(file), report)
+	assert.Nil(t, err)
+
+	catalog.Validate(report, nil, nil)
+	assert.Equal(t, []ImportError{
+		{Row: "line 3", Message: "size G not found in category"},
+		{Row: "line 4", Message: "code PZ1 duplicated"},
+		{Row: "line 4", Message: "cost must be less than price"},
+	}, report.Errors)
+}
+
+func TestCatalogValidateCategoryWithoutName(t *testing.T) {
+	catalog := &Catalog{Categories: []CatalogCategory{{
+		Sizes:    []CatalogSize{{Name: "P"}},
+		Products: []CatalogProduct{{Code: "PZ1", Name: "Calabresa", Size: "P", Price: 30}},
+	}}}
+
+	report := NewImportReport(true)
+	assert.NotPanics(t, func() { catalog.Validate(report, nil, nil) })
+	assert.Equal(t, []ImportError{{Row: "categories[0]", Message: "category name is required"}}, report.Errors)
+}
@@ -0,0 +1,45 @@
+package catalogdto
+
+// ImportError points to the row of the file with the problem.
+// Rows are line numbers on CSV files and paths like categories[0].products[1] on JSON files.
+type ImportError struct {
+	Row     string `json:"row"`
+	Message string `json:"message"`
+}
+
+type ImportCount struct {
+	Created int `json:"created"`
+	Updated int `json:"updated"`
+}
+
+type ImportReport struct {
+	DryRun       bool          `json:"dry_run"`
+	Imported     bool          `json:"imported"`
+	Errors       []ImportError `json:"errors"`
+	Categories   ImportCount   `json:"categories"`
+	Sizes        ImportCount   `json:"sizes"`
+	Quantities   ImportCount   `json:"quantities"`
+	ProcessRules ImportCount   `json:"process_rules"`
+	Products     ImportCount   `json:"products"`
+}
+
+func NewImportReport(dryRun bool) *ImportReport {
+	return &ImportReport{DryRun: dryRun, Errors: []ImportError{}}
+}
+
+func (r *ImportReport) AddError(row string, message string) {
+	r.Errors = append(r.Errors, ImportError{Row: row, Message: message})
+}
+
+func (r *ImportReport) HasErrors() bool {
+	return len(r.Errors) != 0
+}
+
+func (c *ImportCount) Add(exists bool) {
+	if exists {
+		c.Updated++
+		return
+	}
+
+	c.Created++
+}
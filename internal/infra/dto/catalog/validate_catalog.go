@@ -0,0 +1,241 @@
+package catalogdto
+
+import (
+	"fmt"
+
+	productentity "github.com/willjrcom/sales-backend-go/internal/domain/product"
+)
+
+// Validate checks the whole catalog before importing, references can point to the file or to the existing catalog.
+func (c *Catalog) Validate(report *ImportReport, categories []productentity.Category, products []productentity.Product) {
+	categorySizes := map[string]map[string]bool{}
+	productCodes := map[string]bool{}
+
+	for _, category := range categories {
+		categorySizes[category.Name] = map[string]bool{}
+
+		for _, size := range category.Sizes {
+			categorySizes[category.Name][size.Name] = true
+		}
+	}
+
+	for _, product := range products {
+		productCodes[product.Code] = true
+	}
+
+	fileCategories := map[string]bool{}
+	fileCodes := map[string]bool{}
+
+	for i, category := range c.Categories {
+		path := fmt.Sprintf("categories[%d]", i)
+
+		if category.Name == "" {
+			report.AddError(c.Row(path), "category name is required")
+			continue
+		}
+
+		if fileCategories[category.Name] {
+			report.AddError(c.Row(path), "category "+category.Name+" duplicated")
+		}
+
+		fileCategories[category.Name] = true
+
+		if _, ok := categorySizes[category.Name]; !ok {
+			categorySizes[category.Name] = map[string]bool{}
+		}
+
+		for _, product := range category.Products {
+			productCodes[product.Code] = true
+		}
+	}
+
+	for i, category := range c.Categories {
+		path := fmt.Sprintf("categories[%d]", i)
+
+		// the category without name is already reported, its sizes and products have no category to belong to
+		if category.Name == "" {
+			continue
+		}
+
+		if err := productentity.ValidateSchedules(category.Schedules); err != nil {
+			report.AddError(c.Row(path), err.Error())
+		}
+
+		fileSizes := map[string]bool{}
+		for j, size := range category.Sizes {
+			sizePath := fmt.Sprintf("%s.sizes[%d]", path, j)
+
+			if size.Name == "" {
+				report.AddError(c.Row(sizePath), "size name is required")
+				continue
+			}
+
+			if fileSizes[size.Name] {
+				report.AddError(c.Row(sizePath), "size "+size.Name+" duplicated")
+			}
+
+			fileSizes[size.Name] = true
+			categorySizes[category.Name][size.Name] = true
+		}
+
+		quantities := map[float64]bool{}
+		for j, quantity := range category.Quantities {
+			quantityPath := fmt.Sprintf("%s.quantities[%d]", path, j)
+
+			if quantity <= 0 {
+				report.AddError(c.Row(quantityPath), "quantity must be greater than zero")
+			}
+
+			if quantities[quantity] {
+				report.AddError(c.Row(quantityPath), productentity.ErrQuantityAlreadyExists.Error())
+			}
+
+			quantities[quantity] = true
+		}
+
+		rules := map[string]bool{}
+		for j, rule := range category.ProcessRules {
+			rulePath := fmt.Sprintf("%s.process_rules[%d]", path, j)
+
+			if rule.Name == "" {
+				report.AddError(c.Row(rulePath), "process rule name is required")
+			}
+
+			if rules[rule.Name] {
+				report.AddError(c.Row(rulePath), "process rule "+rule.Name+" duplicated")
+			}
+
+			rules[rule.Name] = true
+		}
+
+		for j, additional := range category.AdditionalCategories {
+			additionalPath := fmt.Sprintf("%s.additional_categories[%d]", path, j)
+
+			if _, ok := categorySizes[additional]; !ok {
+				report.AddError(c.Row(additionalPath), "additional category "+additional+" not found")
+			}
+
+			if additional == category.Name {
+				report.AddError(c.Row(additionalPath), "category can't be additional of itself")
+			}
+		}
+	}
+
+	for i, category := range c.Categories {
+		if category.Name == "" {
+			continue
+		}
+
+		for j, product := range category.Products {
+			path := fmt.Sprintf("categories[%d].products[%d]", i, j)
+
+			if product.Code == "" {
+				report.AddError(c.Row(path), "code is required")
+				continue
+			}
+
+			if fileCodes[product.Code] {
+				report.AddError(c.Row(path), "code "+product.Code+" duplicated")
+			}
+
+			fileCodes[product.Code] = true
+			c.validateProduct(report, path, product, categorySizes, productCodes, categorySizes[category.Name])
+		}
+	}
+}
+
+func (c *Catalog) validateProduct(report *ImportReport, path string, product CatalogProduct, categories map[string]map[string]bool, productCodes map[string]bool, sizes map[string]bool) {
+	if product.Name == "" {
+		report.AddError(c.Row(path), "name is required")
+	}
+
+	if product.Price < product.Cost {
+		report.AddError(c.Row(path), "cost must be less than price")
+	}
+
+	if product.Type != "" && product.Type != productentity.ProductTypeSimple && product.Type != productentity.ProductTypeCombo {
+		report.AddError(c.Row(path), "product type is invalid")
+	}
+
+	if product.Size == "" && len(product.Variants) == 0 {
+		report.AddError(c.Row(path), "size is required")
+	} else if product.Size != "" && !sizes[product.Size] {
+		report.AddError(c.Row(path), "size "+product.Size+" not found in category")
+	}
+
+	if err := productentity.ValidateSchedules(product.Schedules); err != nil {
+		report.AddError(c.Row(path), err.Error())
+	}
+
+	variantSizes := map[string]bool{}
+	for k, variant := range product.Variants {
+		variantPath := fmt.Sprintf("%s.variants[%d]", path, k)
+
+		if !sizes[variant.Size] {
+			report.AddError(c.Row(variantPath), "size "+variant.Size+" not found in category")
+		}
+
+		if variantSizes[variant.Size] {
+			report.AddError(c.Row(variantPath), productentity.ErrVariantSizeDuplicated.Error())
+		}
+
+		if variant.Price < variant.Cost {
+			report.AddError(c.Row(variantPath), productentity.ErrVariantCostGreaterPrice.Error())
+		}
+
+		variantSizes[variant.Size] = true
+	}
+
+	if product.Type != productentity.ProductTypeCombo {
+		if len(product.ComboSlots) != 0 {
+			report.AddError(c.Row(path), productentity.ErrProductIsNotCombo.Error())
+		}
+
+		return
+	}
+
+	if len(product.ComboSlots) == 0 {
+		report.AddError(c.Row(path), productentity.ErrComboWithoutSlots.Error())
+	}
+
+	for k, slot := range product.ComboSlots {
+		slotPath := fmt.Sprintf("%s.combo_slots[%d]", path, k)
+
+		if slot.Name == "" {
+			report.AddError(c.Row(slotPath), productentity.ErrComboSlotNameRequired.Error())
+		}
+
+		if slot.Category == "" && len(slot.Options) == 0 {
+			report.AddError(c.Row(slotPath), productentity.ErrComboSlotWithoutRule.Error())
+		}
+
+		if _, ok := categories[slot.Category]; slot.Category != "" && !ok {
+			report.AddError(c.Row(slotPath), "slot category "+slot.Category+" not found")
+		}
+
+		for l, option := range slot.Options {
+			optionPath := fmt.Sprintf("%s.options[%d]", slotPath, l)
+
+			if !productCodes[option.ProductCode] {
+				report.AddError(c.Row(optionPath), "product "+option.ProductCode+" not found")
+			}
+
+			if option.ProductCode == product.Code {
+				report.AddError(c.Row(optionPath), productentity.ErrComboComponentIsCombo.Error())
+			}
+
+			if option.Upcharge < 0 {
+				report.AddError(c.Row(optionPath), productentity.ErrComboUpchargeMustBePositive.Error())
+			}
+		}
+	}
+}
+
+// Row returns the CSV line of the path when the catalog was read from a CSV file.
+func (c *Catalog) Row(path string) string {
+	if row, ok := c.rows[path]; ok {
+		return row
+	}
+
+	return path
+}
@@ -0,0 +1,17 @@
+package categorydto
+
+import (
+	productentity "github.com/willjrcom/sales-backend-go/internal/domain/product"
+)
+
+type CloneCategoryInput struct {
+	productentity.CloneCategoryOptions
+}
+
+func (c *CloneCategoryInput) ToOptions() (productentity.CloneCategoryOptions, error) {
+	if err := c.Validate(); err != nil {
+		return productentity.CloneCategoryOptions{}, err
+	}
+
+	return c.CloneCategoryOptions, nil
+}
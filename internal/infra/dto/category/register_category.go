@@ -19,6 +19,10 @@ func (c *RegisterCategoryInput) validate() error {
 		return ErrNameIsEmpty
 	}
 
+	if err := productentity.ValidateSchedules(c.Schedules); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -33,6 +37,7 @@ func (c *RegisterCategoryInput) ToModel() (*productentity.Category, error) {
 		RemovableIngredients: c.RemovableIngredients,
 		ImagePath:            c.ImagePath,
 		NeedPrint:            c.NeedPrint,
+		Schedules:            c.Schedules,
 	}
 
 	return productentity.NewCategory(categoryCommonAttributes), nil
@@ -27,5 +27,13 @@ func (c *UpdateCategoryInput) UpdateModel(category *productentity.Category) (err
 		category.AdditionalCategories = c.AdditionalCategories
 	}
 
+	if c.Schedules != nil {
+		if err := productentity.ValidateSchedules(c.Schedules); err != nil {
+			return err
+		}
+
+		category.Schedules = c.Schedules
+	}
+
 	return nil
 }
@@ -0,0 +1,50 @@
+package clientdto
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	cliententity "github.com/willjrcom/sales-backend-go/internal/domain/client"
+)
+
+var (
+	ErrSurvivorIDRequired = errors.New("survivor id is required")
+	ErrMergedIDsRequired  = errors.New("merged ids are required")
+)
+
+type DuplicateClientOutput struct {
+	Score     float64      `json:"score"`
+	Matches   []string     `json:"matches"`
+	Client    ClientOutput `json:"client"`
+	Duplicate ClientOutput `json:"duplicate"`
+}
+
+func (d *DuplicateClientOutput) FromModel(candidate cliententity.DuplicateCandidate, client *cliententity.Client, duplicate *cliententity.Client) {
+	d.Score = candidate.Score
+	d.Matches = candidate.Matches
+	d.Client.FromModel(client)
+	d.Duplicate.FromModel(duplicate)
+}
+
+type MergeClientsInput struct {
+	SurvivorID uuid.UUID   `json:"survivor_id"`
+	MergedIDs  []uuid.UUID `json:"merged_ids"`
+}
+
+func (m *MergeClientsInput) Validate() error {
+	if m.SurvivorID == uuid.Nil {
+		return ErrSurvivorIDRequired
+	}
+
+	if len(m.MergedIDs) == 0 {
+		return ErrMergedIDsRequired
+	}
+
+	for _, id := range m.MergedIDs {
+		if id == m.SurvivorID {
+			return cliententity.ErrMergeSameClient
+		}
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package clientdto
 
 import (
 	"github.com/google/uuid"
+	addressentity "github.com/willjrcom/sales-backend-go/internal/domain/address"
 	cliententity "github.com/willjrcom/sales-backend-go/internal/domain/client"
 	personentity "github.com/willjrcom/sales-backend-go/internal/domain/person"
 )
@@ -9,9 +10,13 @@ import (
 type ClientOutput struct {
 	ID uuid.UUID `json:"id"`
 	personentity.PersonCommonAttributes
+	Addresses []addressentity.Address `json:"addresses"`
+	Contacts  []personentity.Contact  `json:"contacts"`
 }
 
 func (c *ClientOutput) FromModel(model *cliententity.Client) {
 	c.ID = model.ID
 	c.PersonCommonAttributes = model.PersonCommonAttributes
+	c.Addresses = model.Addresses
+	c.Contacts = model.Contacts
 }
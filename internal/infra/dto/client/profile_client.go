@@ -0,0 +1,63 @@
+package clientdto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	cliententity "github.com/willjrcom/sales-backend-go/internal/domain/client"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+)
+
+const favouriteItemsLimit = 5
+
+type ClientProfileOutput struct {
+	Client         ClientOutput                `json:"client"`
+	Stats          cliententity.ClientStats    `json:"stats"`
+	AverageTicket  float64                     `json:"average_ticket"`
+	FavouriteItems []orderentity.FavouriteItem `json:"favourite_items"`
+	Orders         []ClientOrderOutput         `json:"orders"`
+}
+
+type ClientOrderOutput struct {
+	ID           uuid.UUID               `json:"id"`
+	OrderNumber  int                     `json:"order_number"`
+	Type         string                  `json:"type"`
+	Status       orderentity.StatusOrder `json:"status"`
+	TotalPayable float64                 `json:"total_payable"`
+	OrderedAt    time.Time               `json:"ordered_at"`
+}
+
+func (c *ClientProfileOutput) FromModel(client *cliententity.Client, orders []orderentity.Order) {
+	c.Client.FromModel(client)
+	c.Stats = orderentity.ClientStatsFromOrders(client.ID, orders)
+	c.AverageTicket = c.Stats.AverageTicket()
+	c.FavouriteItems = orderentity.FavouriteItems(orders, favouriteItemsLimit)
+
+	c.Orders = []ClientOrderOutput{}
+	for i := range orders {
+		order := &orders[i]
+
+		orderOutput := ClientOrderOutput{
+			ID:           order.ID,
+			OrderNumber:  order.OrderNumber,
+			Status:       order.Status,
+			TotalPayable: order.TotalPayable,
+			OrderedAt:    order.CreatedAt,
+		}
+
+		if order.PendingAt != nil {
+			orderOutput.OrderedAt = *order.PendingAt
+		}
+
+		switch {
+		case order.Delivery != nil:
+			orderOutput.Type = "delivery"
+		case order.Pickup != nil:
+			orderOutput.Type = "pickup"
+		case order.Table != nil:
+			orderOutput.Type = "table"
+		}
+
+		c.Orders = append(c.Orders, orderOutput)
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	cliententity "github.com/willjrcom/sales-backend-go/internal/domain/client"
 	personentity "github.com/willjrcom/sales-backend-go/internal/domain/person"
+	"github.com/willjrcom/sales-backend-go/pkg/document"
 )
 
 var (
@@ -52,8 +53,13 @@ func (r *RegisterClientInput) ToModel() (*cliententity.Client, error) {
 	if r.Email != nil {
 		person.Email = *r.Email
 	}
-	if r.Cpf != nil {
-		person.Cpf = *r.Cpf
+	if r.Cpf != nil && *r.Cpf != "" {
+		cpf, err := document.FormatCpf(*r.Cpf)
+		if err != nil {
+			return nil, err
+		}
+
+		person.Cpf = cpf
 	}
 	if r.Birthday != nil {
 		person.Birthday = r.Birthday
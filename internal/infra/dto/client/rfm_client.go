@@ -0,0 +1,84 @@
+package clientdto
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+
+	cliententity "github.com/willjrcom/sales-backend-go/internal/domain/client"
+)
+
+var csvRFMHeader = []string{"client_id", "name", "email", "phone", "recency_days", "orders", "total_spent", "r", "f", "m", "segment"}
+
+type ClientRFMOutput struct {
+	cliententity.RFMScore
+	Name  string `json:"name"`
+	Email string `json:"email,omitempty"`
+	Phone string `json:"phone,omitempty"`
+}
+
+type RFMOutput struct {
+	Segments map[cliententity.Segment]int `json:"segments"`
+	Clients  []ClientRFMOutput            `json:"clients"`
+}
+
+func (c *ClientRFMOutput) FromModel(score cliententity.RFMScore, client *cliententity.Client) {
+	c.RFMScore = score
+
+	if client == nil {
+		return
+	}
+
+	c.Name = client.Name
+	c.Email = client.Email
+
+	if client.Contact != nil {
+		c.Phone = client.Contact.Ddd + client.Contact.Number
+	}
+}
+
+func (o *RFMOutput) FromModel(scores []cliententity.RFMScore, clients map[string]*cliententity.Client) {
+	o.Segments = map[cliententity.Segment]int{}
+	for _, segment := range cliententity.GetAllSegments() {
+		o.Segments[segment] = 0
+	}
+
+	o.Clients = []ClientRFMOutput{}
+	for _, score := range scores {
+		clientOutput := ClientRFMOutput{}
+		clientOutput.FromModel(score, clients[score.ClientID.String()])
+
+		o.Segments[score.Segment]++
+		o.Clients = append(o.Clients, clientOutput)
+	}
+}
+
+// WriteCSV writes the clients with one row per client.
+func (o *RFMOutput) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write(csvRFMHeader); err != nil {
+		return err
+	}
+
+	for _, client := range o.Clients {
+		if err := writer.Write([]string{
+			client.ClientID.String(),
+			client.Name,
+			client.Email,
+			client.Phone,
+			strconv.Itoa(client.RecencyDays),
+			strconv.Itoa(client.OrderCount),
+			strconv.FormatFloat(client.TotalSpent, 'f', 2, 64),
+			strconv.Itoa(client.Recency),
+			strconv.Itoa(client.Frequency),
+			strconv.Itoa(client.Monetary),
+			string(client.Segment),
+		}); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
@@ -4,8 +4,10 @@ import (
 	"errors"
 	"strings"
 
+	addressentity "github.com/willjrcom/sales-backend-go/internal/domain/address"
 	cliententity "github.com/willjrcom/sales-backend-go/internal/domain/client"
 	personentity "github.com/willjrcom/sales-backend-go/internal/domain/person"
+	"github.com/willjrcom/sales-backend-go/pkg/document"
 )
 
 var (
@@ -36,21 +38,65 @@ func (r *UpdateClientInput) UpdateModel(client *cliententity.Client) error {
 		client.Email = *r.Email
 	}
 	if r.Cpf != nil {
-		client.Cpf = *r.Cpf
+		cpf := *r.Cpf
+		if cpf != "" {
+			formatted, err := document.FormatCpf(cpf)
+			if err != nil {
+				return err
+			}
+
+			cpf = formatted
+		}
+
+		client.Cpf = cpf
 	}
 	if r.Birthday != nil {
 		client.Birthday = r.Birthday
 	}
 	if r.Contact != nil {
-		if err := client.AddContact(r.Contact, personentity.ContactTypeEmployee); err != nil {
+		if err := updateDefaultContact(client, *r.Contact); err != nil {
 			return err
 		}
 	}
 	if r.Address != nil {
-		if err := client.AddAddress(&r.Address.AddressCommonAttributes); err != nil {
+		if err := updateDefaultAddress(client, r.Address.AddressCommonAttributes); err != nil {
 			return err
 		}
 	}
 
 	return nil
 }
+
+// updateDefaultContact replaces the number of the default contact, keeping the other contacts.
+func updateDefaultContact(client *cliententity.Client, contact string) error {
+	if client.Contact == nil {
+		return client.AddContact(&contact, personentity.ContactTypeClient)
+	}
+
+	ddd, number, err := personentity.ValidateAndExtractContact(contact)
+
+	if err != nil {
+		return err
+	}
+
+	client.Contact.Ddd = ddd
+	client.Contact.Number = number
+	client.Contact.Type = personentity.ContactTypeClient
+	return nil
+}
+
+// updateDefaultAddress replaces the default address, keeping the other addresses.
+func updateDefaultAddress(client *cliententity.Client, address addressentity.AddressCommonAttributes) error {
+	if client.Address == nil {
+		return client.AddAddress(&address)
+	}
+
+	if address.Label == "" {
+		address.Label = client.Address.Label
+	}
+
+	address.ObjectID = client.ID
+	address.IsDefault = true
+	client.Address.AddressCommonAttributes = address
+	return client.Address.Validate()
+}
@@ -2,13 +2,17 @@ package companydto
 
 import (
 	"errors"
+	"time"
 
 	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+	"github.com/willjrcom/sales-backend-go/internal/infra/service/cnpj"
+	"github.com/willjrcom/sales-backend-go/pkg/document"
 )
 
 var (
-	ErrMustBeCNPJ     = errors.New("cnpj is required")
-	ErrMustBeContacts = errors.New("contacts is required")
+	ErrMustBeCNPJ      = errors.New("cnpj is required")
+	ErrMustBeContacts  = errors.New("contacts is required")
+	ErrInvalidTimezone = errors.New("timezone is invalid")
 )
 
 type CompanyInput struct {
@@ -20,9 +24,19 @@ func (c *CompanyInput) validate() error {
 		return ErrMustBeCNPJ
 	}
 
+	if err := document.ValidateCnpj(c.Cnpj); err != nil {
+		return err
+	}
+
 	if len(c.Contacts) == 0 {
 		return ErrMustBeContacts
 	}
+
+	if c.Timezone != "" {
+		if _, err := time.LoadLocation(c.Timezone); err != nil {
+			return ErrInvalidTimezone
+		}
+	}
 	return nil
 }
 
@@ -33,3 +47,32 @@ func (c *CompanyInput) ToModel() (cnpj string, tradeName string, email string, c
 
 	return c.Cnpj, c.TradeName, c.Email, c.Contacts, nil
 }
+
+// ManualCnpj returns the company data typed by the user, used when the cnpj provider is down.
+// The business name, the trade name and the address are required.
+func (c *CompanyInput) ManualCnpj() (*cnpj.Cnpj, bool) {
+	if c.BusinessName == "" || c.TradeName == "" || c.Address == nil {
+		return nil, false
+	}
+
+	if err := c.Address.Validate(); err != nil {
+		return nil, false
+	}
+
+	formatted, err := document.FormatCnpj(c.Cnpj)
+	if err != nil {
+		return nil, false
+	}
+
+	return &cnpj.Cnpj{
+		Cnpj:         formatted,
+		BusinessName: c.BusinessName,
+		TradeName:    c.TradeName,
+		Street:       c.Address.Street,
+		Number:       c.Address.Number,
+		City:         c.Address.City,
+		Neighborhood: c.Address.Neighborhood,
+		State:        c.Address.State,
+		Cep:          c.Address.Cep,
+	}, true
+}
@@ -14,7 +14,8 @@ var (
 )
 
 type CreateDeliveryOrderInput struct {
-	ClientID uuid.UUID `json:"client_id"`
+	ClientID  uuid.UUID  `json:"client_id"`
+	AddressID *uuid.UUID `json:"address_id"`
 }
 
 func (o *CreateDeliveryOrderInput) validate() error {
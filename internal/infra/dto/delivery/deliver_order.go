@@ -0,0 +1,17 @@
+package deliveryorderdto
+
+import (
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+	orderdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/order"
+)
+
+// DeliverOrderInput is the proof of the delivery, every field is optional.
+// The payment is the amount collected by the driver on the delivery.
+type DeliverOrderInput struct {
+	RecipientName string                     `json:"recipient_name"`
+	Payment       *orderdto.AddPaymentMethod `json:"payment"`
+}
+
+func (d *DeliverOrderInput) ToModel() *orderentity.DeliveryProof {
+	return &orderentity.DeliveryProof{RecipientName: d.RecipientName}
+}
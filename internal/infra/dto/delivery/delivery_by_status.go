@@ -0,0 +1,24 @@
+package deliveryorderdto
+
+import (
+	"errors"
+	"slices"
+
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+)
+
+var (
+	ErrDeliveryStatusInvalid = errors.New("delivery status is invalid")
+)
+
+type DeliveryOrderByStatusInput struct {
+	Status orderentity.StatusDeliveryOrder `json:"status"`
+}
+
+func (i *DeliveryOrderByStatusInput) Validate() error {
+	if !slices.Contains(orderentity.GetAllDeliveryStatus(), i.Status) {
+		return ErrDeliveryStatusInvalid
+	}
+
+	return nil
+}
@@ -0,0 +1,6 @@
+package deliveryorderdto
+
+// DeliveryReasonInput is the reason of a failed or canceled delivery.
+type DeliveryReasonInput struct {
+	Reason string `json:"reason"`
+}
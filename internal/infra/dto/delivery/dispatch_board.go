@@ -0,0 +1,16 @@
+package deliveryorderdto
+
+import (
+	"github.com/google/uuid"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+)
+
+// DispatchBoardOutput is the deliveries waiting for a driver, grouped in suggested batches.
+type DispatchBoardOutput struct {
+	Batches []orderentity.DeliveryBatch `json:"batches"`
+	Total   int                         `json:"total"`
+}
+
+type DeliveryBatchIDOutput struct {
+	BatchID uuid.UUID `json:"batch_id"`
+}
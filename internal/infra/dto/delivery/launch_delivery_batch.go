@@ -0,0 +1,39 @@
+package deliveryorderdto
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrDeliveryIDsRequired = errors.New("delivery ids are required")
+	ErrDeliveryIDRepeated  = errors.New("delivery id is repeated")
+)
+
+// LaunchDeliveryBatchInput assigns the deliveries to the driver, the ids are in the order of the stops.
+type LaunchDeliveryBatchInput struct {
+	DriverID    *uuid.UUID  `json:"driver_id"`
+	DeliveryIDs []uuid.UUID `json:"delivery_ids"`
+}
+
+func (l *LaunchDeliveryBatchInput) Validate() error {
+	if l.DriverID == nil {
+		return ErrInvalidDriverID
+	}
+
+	if len(l.DeliveryIDs) == 0 {
+		return ErrDeliveryIDsRequired
+	}
+
+	seen := map[uuid.UUID]bool{}
+	for _, id := range l.DeliveryIDs {
+		if seen[id] {
+			return ErrDeliveryIDRepeated
+		}
+
+		seen[id] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package deliveryzonedto
+
+import (
+	"github.com/google/uuid"
+	deliveryzoneentity "github.com/willjrcom/sales-backend-go/internal/domain/delivery_zone"
+)
+
+type RegisterDeliveryZoneInput struct {
+	deliveryzoneentity.DeliveryZoneCommonAttributes
+}
+
+func (r *RegisterDeliveryZoneInput) ToModel() (*deliveryzoneentity.DeliveryZone, error) {
+	zone := deliveryzoneentity.NewDeliveryZone(r.DeliveryZoneCommonAttributes)
+
+	if err := zone.Validate(); err != nil {
+		return nil, err
+	}
+
+	return zone, nil
+}
+
+type UpdateDeliveryZoneInput struct {
+	deliveryzoneentity.PatchDeliveryZone
+}
+
+func (u *UpdateDeliveryZoneInput) UpdateModel(zone *deliveryzoneentity.DeliveryZone) error {
+	if u.Name != nil {
+		zone.Name = *u.Name
+	}
+	if u.Type != nil {
+		zone.Type = *u.Type
+	}
+	if u.City != nil {
+		zone.City = *u.City
+	}
+	if u.Neighborhoods != nil {
+		zone.Neighborhoods = u.Neighborhoods
+	}
+	if u.CepRanges != nil {
+		zone.CepRanges = u.CepRanges
+	}
+	if u.RadiusKm != nil {
+		zone.RadiusKm = *u.RadiusKm
+	}
+	if u.Polygon != nil {
+		zone.Polygon = u.Polygon
+	}
+	if u.Fee != nil {
+		zone.Fee = *u.Fee
+	}
+	if u.MinOrderValue != nil {
+		zone.MinOrderValue = *u.MinOrderValue
+	}
+	if u.EstimatedTime != nil {
+		zone.EstimatedTime = *u.EstimatedTime
+	}
+	if u.IsActive != nil {
+		zone.IsActive = *u.IsActive
+	}
+
+	return zone.Validate()
+}
+
+type DeliveryZoneOutput struct {
+	ID uuid.UUID `json:"id"`
+	deliveryzoneentity.DeliveryZoneCommonAttributes
+}
+
+func (o *DeliveryZoneOutput) FromModel(model *deliveryzoneentity.DeliveryZone) {
+	o.ID = model.ID
+	o.DeliveryZoneCommonAttributes = model.DeliveryZoneCommonAttributes
+}
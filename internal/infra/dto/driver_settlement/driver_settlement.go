@@ -0,0 +1,34 @@
+package driversettlementdto
+
+import (
+	"github.com/google/uuid"
+	driversettlemententity "github.com/willjrcom/sales-backend-go/internal/domain/driver_settlement"
+)
+
+// RegisterDriverSettlementInput opens the report of the driver for a shift or a date range.
+// The pay rate is the value per delivery, or the share of the delivery fee from 0 to 1.
+type RegisterDriverSettlementInput struct {
+	driversettlemententity.SettlementPeriod
+	PayType driversettlemententity.PayType `json:"pay_type"`
+	PayRate float64                        `json:"pay_rate"`
+}
+
+func (r *RegisterDriverSettlementInput) ToModel() (*driversettlemententity.DriverSettlement, error) {
+	settlement := driversettlemententity.NewDriverSettlement(r.SettlementPeriod, r.PayType, r.PayRate)
+
+	if err := settlement.Validate(); err != nil {
+		return nil, err
+	}
+
+	return settlement, nil
+}
+
+type DriverSettlementOutput struct {
+	ID uuid.UUID `json:"id"`
+	driversettlemententity.DriverSettlementCommonAttributes
+}
+
+func (o *DriverSettlementOutput) FromModel(model *driversettlemententity.DriverSettlement) {
+	o.ID = model.ID
+	o.DriverSettlementCommonAttributes = model.DriverSettlementCommonAttributes
+}
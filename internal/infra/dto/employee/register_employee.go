@@ -6,6 +6,7 @@ import (
 
 	employeeentity "github.com/willjrcom/sales-backend-go/internal/domain/employee"
 	personentity "github.com/willjrcom/sales-backend-go/internal/domain/person"
+	"github.com/willjrcom/sales-backend-go/pkg/document"
 )
 
 var (
@@ -52,8 +53,13 @@ func (r *RegisterEmployeeInput) ToModel() (*employeeentity.Employee, error) {
 	if r.Email != nil {
 		person.Email = *r.Email
 	}
-	if r.Cpf != nil {
-		person.Cpf = *r.Cpf
+	if r.Cpf != nil && *r.Cpf != "" {
+		cpf, err := document.FormatCpf(*r.Cpf)
+		if err != nil {
+			return nil, err
+		}
+
+		person.Cpf = cpf
 	}
 	if r.Birthday != nil {
 		person.Birthday = r.Birthday
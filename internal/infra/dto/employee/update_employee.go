@@ -6,6 +6,7 @@ import (
 
 	employeeentity "github.com/willjrcom/sales-backend-go/internal/domain/employee"
 	personentity "github.com/willjrcom/sales-backend-go/internal/domain/person"
+	"github.com/willjrcom/sales-backend-go/pkg/document"
 )
 
 var (
@@ -36,7 +37,17 @@ func (r *UpdateEmployeeInput) UpdateModel(client *employeeentity.Employee) error
 		client.Email = *r.Email
 	}
 	if r.Cpf != nil {
-		client.Cpf = *r.Cpf
+		cpf := *r.Cpf
+		if cpf != "" {
+			formatted, err := document.FormatCpf(cpf)
+			if err != nil {
+				return err
+			}
+
+			cpf = formatted
+		}
+
+		client.Cpf = cpf
 	}
 	if r.Birthday != nil {
 		client.Birthday = r.Birthday
@@ -0,0 +1,89 @@
+package itemdto
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	productentity "github.com/willjrcom/sales-backend-go/internal/domain/product"
+)
+
+var (
+	ErrComboComponentsRequired = errors.New("combo components are required")
+)
+
+type AddComboOrderInput struct {
+	OrderID     uuid.UUID             `json:"order_id"`
+	ProductID   uuid.UUID             `json:"product_id"`
+	Observation string                `json:"observation"`
+	Components  []ComboComponentInput `json:"components"`
+}
+
+type ComboComponentInput struct {
+	SlotID      uuid.UUID  `json:"slot_id"`
+	ProductID   uuid.UUID  `json:"product_id"`
+	QuantityID  uuid.UUID  `json:"quantity_id"`
+	SizeID      *uuid.UUID `json:"size_id"`
+	Observation string     `json:"observation"`
+}
+
+func (a *AddComboOrderInput) validate() error {
+	if a.OrderID == uuid.Nil {
+		return errors.New("order id is required")
+	}
+
+	if a.ProductID == uuid.Nil {
+		return errors.New("product id is required")
+	}
+
+	if len(a.Components) == 0 {
+		return ErrComboComponentsRequired
+	}
+
+	for _, component := range a.Components {
+		if component.SlotID == uuid.Nil {
+			return errors.New("slot id is required")
+		}
+
+		if component.ProductID == uuid.Nil {
+			return errors.New("component product id is required")
+		}
+
+		if component.QuantityID == uuid.Nil {
+			return errors.New("component quantity id is required")
+		}
+	}
+
+	return nil
+}
+
+// ValidateSlots checks if every slot of the combo is filled exactly once.
+func (a *AddComboOrderInput) ValidateSlots(combo *productentity.Product) error {
+	if err := a.validate(); err != nil {
+		return err
+	}
+
+	if !combo.IsCombo() {
+		return productentity.ErrProductIsNotCombo
+	}
+
+	filled := map[uuid.UUID]bool{}
+	for _, component := range a.Components {
+		if _, err := combo.GetComboSlot(component.SlotID); err != nil {
+			return err
+		}
+
+		if filled[component.SlotID] {
+			return productentity.ErrComboSlotAlreadyFilled
+		}
+
+		filled[component.SlotID] = true
+	}
+
+	for _, slot := range combo.ComboSlots {
+		if !filled[slot.ID] {
+			return productentity.ErrComboSlotNotFilled
+		}
+	}
+
+	return nil
+}
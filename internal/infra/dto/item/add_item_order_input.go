@@ -19,6 +19,7 @@ type AddItemOrderInput struct {
 	OrderID     uuid.UUID  `json:"order_id"`
 	ProductID   uuid.UUID  `json:"product_id"`
 	QuantityID  uuid.UUID  `json:"quantity_id"`
+	SizeID      *uuid.UUID `json:"size_id"`
 	GroupItemID *uuid.UUID `json:"group_item_id"`
 	Observation string     `json:"observation"`
 }
@@ -0,0 +1,19 @@
+package itemdto
+
+import "github.com/google/uuid"
+
+type ComboOutput struct {
+	ComboID uuid.UUID                  `json:"combo_id"`
+	Items   []ItemIDAndGroupItemOutput `json:"items"`
+}
+
+func NewComboOutput(comboID uuid.UUID) *ComboOutput {
+	return &ComboOutput{
+		ComboID: comboID,
+		Items:   []ItemIDAndGroupItemOutput{},
+	}
+}
+
+func (c *ComboOutput) AddItem(itemID uuid.UUID, groupItemID uuid.UUID) {
+	c.Items = append(c.Items, *NewOutput(itemID, groupItemID))
+}
@@ -0,0 +1,31 @@
+package loyaltydto
+
+import (
+	"github.com/google/uuid"
+	loyaltyentity "github.com/willjrcom/sales-backend-go/internal/domain/loyalty"
+)
+
+type UpdateProgramInput struct {
+	loyaltyentity.PatchLoyaltyProgram
+}
+
+func (p *UpdateProgramInput) UpdateModel(program *loyaltyentity.LoyaltyProgram) error {
+	if p.IsActive != nil {
+		program.IsActive = *p.IsActive
+	}
+	if p.PointsPerCurrency != nil {
+		program.PointsPerCurrency = *p.PointsPerCurrency
+	}
+
+	return program.Validate()
+}
+
+type ProgramOutput struct {
+	ID uuid.UUID `json:"id"`
+	loyaltyentity.LoyaltyProgramCommonAttributes
+}
+
+func (p *ProgramOutput) FromModel(model *loyaltyentity.LoyaltyProgram) {
+	p.ID = model.ID
+	p.LoyaltyProgramCommonAttributes = model.LoyaltyProgramCommonAttributes
+}
@@ -0,0 +1,35 @@
+package loyaltydto
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrClientIDRequired = errors.New("client id is required")
+	ErrOrderIDRequired  = errors.New("order id is required")
+	ErrRewardIDRequired = errors.New("reward id is required")
+)
+
+type RedeemRewardInput struct {
+	ClientID uuid.UUID `json:"client_id"`
+	OrderID  uuid.UUID `json:"order_id"`
+	RewardID uuid.UUID `json:"reward_id"`
+}
+
+func (r *RedeemRewardInput) Validate() error {
+	if r.ClientID == uuid.Nil {
+		return ErrClientIDRequired
+	}
+
+	if r.OrderID == uuid.Nil {
+		return ErrOrderIDRequired
+	}
+
+	if r.RewardID == uuid.Nil {
+		return ErrRewardIDRequired
+	}
+
+	return nil
+}
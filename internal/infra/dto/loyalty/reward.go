@@ -0,0 +1,57 @@
+package loyaltydto
+
+import (
+	"github.com/google/uuid"
+	loyaltyentity "github.com/willjrcom/sales-backend-go/internal/domain/loyalty"
+)
+
+type RegisterRewardInput struct {
+	loyaltyentity.RewardCommonAttributes
+}
+
+func (r *RegisterRewardInput) ToModel() (*loyaltyentity.Reward, error) {
+	reward := loyaltyentity.NewReward(r.RewardCommonAttributes)
+
+	if err := reward.Validate(); err != nil {
+		return nil, err
+	}
+
+	return reward, nil
+}
+
+type UpdateRewardInput struct {
+	loyaltyentity.PatchReward
+}
+
+func (r *UpdateRewardInput) UpdateModel(reward *loyaltyentity.Reward) error {
+	if r.Name != nil {
+		reward.Name = *r.Name
+	}
+	if r.Type != nil {
+		reward.Type = *r.Type
+	}
+	if r.Points != nil {
+		reward.Points = *r.Points
+	}
+	if r.ProductID != nil {
+		reward.ProductID = r.ProductID
+	}
+	if r.Discount != nil {
+		reward.Discount = *r.Discount
+	}
+	if r.IsActive != nil {
+		reward.IsActive = *r.IsActive
+	}
+
+	return reward.Validate()
+}
+
+type RewardOutput struct {
+	ID uuid.UUID `json:"id"`
+	loyaltyentity.RewardCommonAttributes
+}
+
+func (r *RewardOutput) FromModel(model *loyaltyentity.Reward) {
+	r.ID = model.ID
+	r.RewardCommonAttributes = model.RewardCommonAttributes
+}
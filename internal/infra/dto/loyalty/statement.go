@@ -0,0 +1,34 @@
+package loyaltydto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	loyaltyentity "github.com/willjrcom/sales-backend-go/internal/domain/loyalty"
+)
+
+type StatementOutput struct {
+	ClientID     uuid.UUID           `json:"client_id"`
+	Balance      int                 `json:"balance"`
+	Transactions []TransactionOutput `json:"transactions"`
+}
+
+type TransactionOutput struct {
+	ID        uuid.UUID `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	loyaltyentity.LoyaltyTransactionCommonAttributes
+}
+
+func (s *StatementOutput) FromModel(clientID uuid.UUID, transactions []loyaltyentity.LoyaltyTransaction) {
+	s.ClientID = clientID
+	s.Balance = loyaltyentity.Balance(transactions)
+	s.Transactions = []TransactionOutput{}
+
+	for _, transaction := range transactions {
+		s.Transactions = append(s.Transactions, TransactionOutput{
+			ID:                                 transaction.ID,
+			CreatedAt:                          transaction.CreatedAt,
+			LoyaltyTransactionCommonAttributes: transaction.LoyaltyTransactionCommonAttributes,
+		})
+	}
+}
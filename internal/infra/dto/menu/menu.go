@@ -0,0 +1,127 @@
+package menudto
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+	productentity "github.com/willjrcom/sales-backend-go/internal/domain/product"
+)
+
+// MenuOutput is the public menu, it must never carry costs or internal fields.
+type MenuOutput struct {
+	Company    MenuCompany    `json:"company"`
+	Categories []MenuCategory `json:"categories"`
+}
+
+type MenuCompany struct {
+	Slug      string   `json:"slug"`
+	TradeName string   `json:"trade_name"`
+	Contacts  []string `json:"contacts,omitempty"`
+}
+
+type MenuCategory struct {
+	ID                    uuid.UUID      `json:"id"`
+	Name                  string         `json:"name"`
+	ImagePath             string         `json:"image_path,omitempty"`
+	RemovableIngredients  []string       `json:"removable_ingredients,omitempty"`
+	Sizes                 []MenuSize     `json:"sizes"`
+	Quantities            []MenuQuantity `json:"quantities"`
+	AdditionalCategoryIDs []uuid.UUID    `json:"additional_category_ids,omitempty"`
+	Products              []MenuProduct  `json:"products"`
+}
+
+type MenuSize struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+}
+
+type MenuQuantity struct {
+	ID       uuid.UUID `json:"id"`
+	Quantity float64   `json:"quantity"`
+}
+
+type MenuProduct struct {
+	ID          uuid.UUID     `json:"id"`
+	Code        string        `json:"code"`
+	Name        string        `json:"name"`
+	Description string        `json:"description,omitempty"`
+	ImagePath   *string       `json:"image_path,omitempty"`
+	Price       float64       `json:"price"`
+	SizeID      uuid.UUID     `json:"size_id"`
+	Variants    []MenuVariant `json:"variants,omitempty"`
+}
+
+type MenuVariant struct {
+	SizeID uuid.UUID `json:"size_id"`
+	Price  float64   `json:"price"`
+}
+
+func (m *MenuOutput) FromModel(company *companyentity.Company, categories []productentity.Category) {
+	m.Company = MenuCompany{
+		Slug:      company.Slug,
+		TradeName: company.TradeName,
+		Contacts:  company.Contacts,
+	}
+
+	m.Categories = []MenuCategory{}
+	for _, category := range categories {
+		c := MenuCategory{}
+		c.FromModel(&category)
+		m.Categories = append(m.Categories, c)
+	}
+}
+
+func (c *MenuCategory) FromModel(model *productentity.Category) {
+	c.ID = model.ID
+	c.Name = model.Name
+	c.ImagePath = model.ImagePath
+	c.RemovableIngredients = model.RemovableIngredients
+
+	c.Sizes = []MenuSize{}
+	for _, size := range model.Sizes {
+		c.Sizes = append(c.Sizes, MenuSize{ID: size.ID, Name: size.Name})
+	}
+
+	c.Quantities = []MenuQuantity{}
+	for _, quantity := range model.Quantities {
+		c.Quantities = append(c.Quantities, MenuQuantity{ID: quantity.ID, Quantity: quantity.Quantity})
+	}
+
+	for _, additional := range model.AdditionalCategories {
+		c.AdditionalCategoryIDs = append(c.AdditionalCategoryIDs, additional.ID)
+	}
+
+	c.Products = []MenuProduct{}
+	for _, product := range model.Products {
+		p := MenuProduct{
+			ID:          product.ID,
+			Code:        product.Code,
+			Name:        product.Name,
+			Description: product.Description,
+			ImagePath:   product.ImagePath,
+			Price:       product.Price,
+			SizeID:      product.SizeID,
+		}
+
+		for _, variant := range product.Variants {
+			p.Variants = append(p.Variants, MenuVariant{SizeID: variant.SizeID, Price: variant.Price})
+		}
+
+		c.Products = append(c.Products, p)
+	}
+}
+
+// ETag is a hash of the menu content, clients send it back on If-None-Match to skip unchanged menus.
+func (m *MenuOutput) ETag() (string, error) {
+	content, err := json.Marshal(m)
+
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(content)
+	return `"` + hex.EncodeToString(hash[:16]) + `"`, nil
+}
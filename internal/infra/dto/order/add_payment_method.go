@@ -43,5 +43,7 @@ func (u *AddPaymentMethod) ToModel(order *orderentity.Order) (*orderentity.Payme
 		return nil, err
 	}
 
-	return orderentity.NewPayment(u.TotalPaid, u.Method, order.ID), nil
+	payment := orderentity.NewPayment(u.TotalPaid, u.Method, order.ID)
+	payment.DriverID = u.DriverID
+	return payment, nil
 }
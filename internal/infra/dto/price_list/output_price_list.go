@@ -0,0 +1,16 @@
+package pricelistdto
+
+import (
+	"github.com/google/uuid"
+	productentity "github.com/willjrcom/sales-backend-go/internal/domain/product"
+)
+
+type PriceListOutput struct {
+	ID uuid.UUID `json:"id"`
+	productentity.PriceListCommonAttributes
+}
+
+func (p *PriceListOutput) FromModel(model *productentity.PriceList) {
+	p.ID = model.ID
+	p.PriceListCommonAttributes = model.PriceListCommonAttributes
+}
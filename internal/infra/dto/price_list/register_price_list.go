@@ -0,0 +1,19 @@
+package pricelistdto
+
+import (
+	productentity "github.com/willjrcom/sales-backend-go/internal/domain/product"
+)
+
+type RegisterPriceListInput struct {
+	productentity.PriceListCommonAttributes
+}
+
+func (p *RegisterPriceListInput) ToModel() (*productentity.PriceList, error) {
+	priceList := productentity.NewPriceList(p.PriceListCommonAttributes)
+
+	if err := priceList.Validate(); err != nil {
+		return nil, err
+	}
+
+	return priceList, nil
+}
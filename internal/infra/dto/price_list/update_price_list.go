@@ -0,0 +1,32 @@
+package pricelistdto
+
+import (
+	productentity "github.com/willjrcom/sales-backend-go/internal/domain/product"
+)
+
+type UpdatePriceListInput struct {
+	productentity.PatchPriceList
+}
+
+func (p *UpdatePriceListInput) UpdateModel(priceList *productentity.PriceList) error {
+	if p.Name != nil {
+		priceList.Name = *p.Name
+	}
+	if p.IsActive != nil {
+		priceList.IsActive = *p.IsActive
+	}
+	if p.Priority != nil {
+		priceList.Priority = *p.Priority
+	}
+	if p.Channels != nil {
+		priceList.Channels = p.Channels
+	}
+	if p.Schedules != nil {
+		priceList.Schedules = p.Schedules
+	}
+	if p.Rules != nil {
+		priceList.SetRules(p.Rules)
+	}
+
+	return priceList.Validate()
+}
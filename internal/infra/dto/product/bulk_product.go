@@ -0,0 +1,35 @@
+package productdto
+
+import (
+	productentity "github.com/willjrcom/sales-backend-go/internal/domain/product"
+)
+
+type BulkPriceInput struct {
+	Filter productentity.ProductFilter `json:"filter"`
+	productentity.PriceAdjustment
+}
+
+func (b *BulkPriceInput) Validate() error {
+	if err := b.Filter.Validate(); err != nil {
+		return err
+	}
+
+	return b.PriceAdjustment.Validate()
+}
+
+type BulkAvailabilityInput struct {
+	Filter      productentity.ProductFilter `json:"filter"`
+	IsAvailable *bool                       `json:"is_available"`
+}
+
+func (b *BulkAvailabilityInput) Validate() error {
+	if err := b.Filter.Validate(); err != nil {
+		return err
+	}
+
+	if b.IsAvailable == nil {
+		return productentity.ErrBatchAvailabilityMissing
+	}
+
+	return nil
+}
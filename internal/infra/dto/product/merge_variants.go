@@ -0,0 +1,44 @@
+package productdto
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrMergeProductsRequired  = errors.New("at least two products are required")
+	ErrMergeProductDuplicated = errors.New("product duplicated in merge")
+)
+
+// MergeVariantsInput merges products registered one per size into the first product of the list.
+type MergeVariantsInput struct {
+	ProductIDs []uuid.UUID `json:"product_ids"`
+	Code       *string     `json:"code"`
+	Name       *string     `json:"name"`
+}
+
+func (m *MergeVariantsInput) validate() error {
+	if len(m.ProductIDs) < 2 {
+		return ErrMergeProductsRequired
+	}
+
+	ids := map[uuid.UUID]bool{}
+	for _, id := range m.ProductIDs {
+		if ids[id] {
+			return ErrMergeProductDuplicated
+		}
+
+		ids[id] = true
+	}
+
+	return nil
+}
+
+func (m *MergeVariantsInput) ToModel() (survivorID uuid.UUID, mergedIDs []uuid.UUID, err error) {
+	if err = m.validate(); err != nil {
+		return uuid.Nil, nil, err
+	}
+
+	return m.ProductIDs[0], m.ProductIDs[1:], nil
+}
@@ -0,0 +1,16 @@
+package productdto
+
+import (
+	"github.com/google/uuid"
+	productentity "github.com/willjrcom/sales-backend-go/internal/domain/product"
+)
+
+type PriceChangeOutput struct {
+	ID uuid.UUID `json:"id"`
+	productentity.ProductPriceChangeCommonAttributes
+}
+
+func (p *PriceChangeOutput) FromModel(model *productentity.ProductPriceChange) {
+	p.ID = model.ID
+	p.ProductPriceChangeCommonAttributes = model.ProductPriceChangeCommonAttributes
+}
@@ -0,0 +1,18 @@
+package productdto
+
+import (
+	"github.com/google/uuid"
+	productentity "github.com/willjrcom/sales-backend-go/internal/domain/product"
+)
+
+type ProductBatchOutput struct {
+	ID      uuid.UUID `json:"id"`
+	Preview bool      `json:"preview"`
+	productentity.ProductBatchCommonAttributes
+}
+
+func (p *ProductBatchOutput) FromModel(model *productentity.ProductBatch, preview bool) {
+	p.ID = model.ID
+	p.Preview = preview
+	p.ProductBatchCommonAttributes = model.ProductBatchCommonAttributes
+}
@@ -15,6 +15,7 @@ var (
 	ErrCostGreaterThanPrice = errors.New("cost must be greater than Price")
 	ErrCategoryRequired     = errors.New("category is required")
 	ErrSizeRequired         = errors.New("size is required")
+	ErrInvalidProductType   = errors.New("product type is invalid")
 )
 
 type RegisterProductInput struct {
@@ -23,6 +24,12 @@ type RegisterProductInput struct {
 }
 
 func (p *RegisterProductInput) validate() error {
+	if len(p.Variants) != 0 {
+		p.SizeID = p.Variants[0].SizeID
+		p.Price = p.Variants[0].Price
+		p.Cost = p.Variants[0].Cost
+	}
+
 	if p.Code == "" {
 		return ErrCodeRequired
 	}
@@ -38,6 +45,15 @@ func (p *RegisterProductInput) validate() error {
 	if p.SizeID == uuid.Nil {
 		return ErrSizeRequired
 	}
+	if p.Type == "" {
+		p.Type = productentity.ProductTypeSimple
+	}
+	if p.Type != productentity.ProductTypeSimple && p.Type != productentity.ProductTypeCombo {
+		return ErrInvalidProductType
+	}
+	if err := productentity.ValidateSchedules(p.Schedules); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -50,16 +66,35 @@ func (p *RegisterProductInput) ToModel() (*productentity.Product, error) {
 	productCommonAttributes := productentity.ProductCommonAttributes{
 		Code:        p.Code,
 		Name:        p.Name,
+		Type:        p.Type,
 		Description: p.Description,
 		SizeID:      p.SizeID,
 		Price:       p.Price,
 		Cost:        p.Cost,
 		CategoryID:  p.CategoryID,
 		IsAvailable: p.IsAvailable,
+		Schedules:   p.Schedules,
 	}
 
-	return &productentity.Product{
+	product := &productentity.Product{
 		Entity:                  entity.NewEntity(),
 		ProductCommonAttributes: productCommonAttributes,
-	}, nil
+	}
+
+	for _, variant := range p.Variants {
+		variant.ProductID = product.ID
+		product.Variants = append(product.Variants, *productentity.NewProductVariant(variant.ProductVariantCommonAttributes))
+	}
+
+	if product.IsCombo() {
+		for _, slot := range p.ComboSlots {
+			product.ComboSlots = append(product.ComboSlots, *productentity.NewComboSlot(slot.ComboSlotCommonAttributes))
+		}
+	}
+
+	if err := product.ValidateComboSlots(); err != nil {
+		return nil, err
+	}
+
+	return product, nil
 }
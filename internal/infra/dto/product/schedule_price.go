@@ -0,0 +1,45 @@
+package productdto
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	productentity "github.com/willjrcom/sales-backend-go/internal/domain/product"
+)
+
+var (
+	ErrEffectiveAtRequired = errors.New("effective at is required")
+)
+
+type SchedulePriceInput struct {
+	Price       float64   `json:"price"`
+	Cost        float64   `json:"cost"`
+	EffectiveAt time.Time `json:"effective_at"`
+}
+
+func (s *SchedulePriceInput) validate() error {
+	if s.EffectiveAt.IsZero() {
+		return ErrEffectiveAtRequired
+	}
+
+	return nil
+}
+
+func (s *SchedulePriceInput) ToModel(productID uuid.UUID, userID *uuid.UUID) (*productentity.ProductPriceChange, error) {
+	if err := s.validate(); err != nil {
+		return nil, err
+	}
+
+	change, err := productentity.NewProductPriceChange(productID, s.Price, s.Cost, s.EffectiveAt, userID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := change.ValidateSchedule(); err != nil {
+		return nil, err
+	}
+
+	return change, nil
+}
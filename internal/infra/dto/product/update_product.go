@@ -18,6 +18,12 @@ func (p *UpdateProductInput) Validate(product *productentity.Product) error {
 	if product.Price < product.Cost {
 		return ErrCostGreaterThanPrice
 	}
+	if err := product.ValidateComboSlots(); err != nil {
+		return err
+	}
+	if err := productentity.ValidateSchedules(product.Schedules); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -47,6 +53,28 @@ func (p *UpdateProductInput) UpdateModel(product *productentity.Product) (err er
 	if p.IsAvailable != nil {
 		product.IsAvailable = *p.IsAvailable
 	}
+	if p.Variants != nil {
+		product.Variants = []productentity.ProductVariant{}
+		for _, variant := range p.Variants {
+			variant.ProductID = product.ID
+			product.Variants = append(product.Variants, *productentity.NewProductVariant(variant.ProductVariantCommonAttributes))
+		}
+
+		if len(product.Variants) != 0 {
+			product.SizeID = product.Variants[0].SizeID
+			product.Price = product.Variants[0].Price
+			product.Cost = product.Variants[0].Cost
+		}
+	}
+	if p.Schedules != nil {
+		product.Schedules = p.Schedules
+	}
+	if p.ComboSlots != nil && product.IsCombo() {
+		product.ComboSlots = []productentity.ComboSlot{}
+		for _, slot := range p.ComboSlots {
+			product.ComboSlots = append(product.ComboSlots, *productentity.NewComboSlot(slot.ComboSlotCommonAttributes))
+		}
+	}
 
 	if err = p.Validate(product); err != nil {
 		return err
@@ -0,0 +1,113 @@
+package selforderdto
+
+import (
+	"github.com/google/uuid"
+	groupitementity "github.com/willjrcom/sales-backend-go/internal/domain/group_item"
+	itementity "github.com/willjrcom/sales-backend-go/internal/domain/item"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+	tableentity "github.com/willjrcom/sales-backend-go/internal/domain/table"
+	itemdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/item"
+)
+
+type OpenSelfOrderInput struct {
+	Name    string `json:"name"`
+	Contact string `json:"contact"`
+}
+
+func (o *OpenSelfOrderInput) ToModel(table *tableentity.Table) *orderentity.TableOrder {
+	tableOrder := orderentity.NewTable(orderentity.TableOrderCommonAttributes{
+		Name:    o.Name,
+		Contact: o.Contact,
+		TableID: table.ID,
+	})
+
+	tableOrder.SelfOrder = true
+	return tableOrder
+}
+
+type AddSelfOrderItemInput struct {
+	ProductID   uuid.UUID                             `json:"product_id"`
+	QuantityID  uuid.UUID                             `json:"quantity_id"`
+	SizeID      *uuid.UUID                            `json:"size_id"`
+	Observation string                                `json:"observation"`
+	Additionals []itemdto.AddAdditionalItemOrderInput `json:"additionals"`
+}
+
+// ToItemInput binds the item to the order of the table, customers never choose the order or the group.
+func (a *AddSelfOrderItemInput) ToItemInput(orderID uuid.UUID) *itemdto.AddItemOrderInput {
+	return &itemdto.AddItemOrderInput{
+		OrderID:     orderID,
+		ProductID:   a.ProductID,
+		QuantityID:  a.QuantityID,
+		SizeID:      a.SizeID,
+		Observation: a.Observation,
+	}
+}
+
+type SelfOrderOutput struct {
+	TableID          uuid.UUID `json:"table_id"`
+	TableName        string    `json:"table_name"`
+	TableOrderID     uuid.UUID `json:"table_order_id"`
+	OrderID          uuid.UUID `json:"order_id"`
+	AwaitingApproval bool      `json:"awaiting_approval"`
+}
+
+func (o *SelfOrderOutput) FromModel(table *tableentity.Table, tableOrder *orderentity.TableOrder) {
+	o.TableID = table.ID
+	o.TableName = table.Name
+	o.TableOrderID = tableOrder.ID
+	o.OrderID = tableOrder.OrderID
+	o.AwaitingApproval = tableOrder.AwaitingApprovalAt != nil
+}
+
+// SelfOrderDetailOutput is the order seen by the customers of the table, it must never carry data of the staff or of other clients.
+type SelfOrderDetailOutput struct {
+	OrderNumber int                     `json:"order_number"`
+	Status      orderentity.StatusOrder `json:"status"`
+	Items       []SelfOrderItemOutput   `json:"items"`
+	Total       float64                 `json:"total"`
+}
+
+type SelfOrderItemOutput struct {
+	Name        string                `json:"name"`
+	Size        string                `json:"size"`
+	Quantity    float64               `json:"quantity"`
+	TotalPrice  float64               `json:"total_price"`
+	Observation string                `json:"observation,omitempty"`
+	Status      itementity.StatusItem `json:"status"`
+	Additionals []string              `json:"additionals,omitempty"`
+}
+
+func (o *SelfOrderDetailOutput) FromModel(order *orderentity.Order) {
+	o.OrderNumber = order.OrderNumber
+	o.Status = order.Status
+	o.Total = order.TotalPayable
+	o.Items = []SelfOrderItemOutput{}
+
+	for _, group := range order.Groups {
+		if group.Status == groupitementity.StatusGroupCanceled {
+			continue
+		}
+
+		for _, item := range group.Items {
+			if item.Status == itementity.StatusItemCanceled {
+				continue
+			}
+
+			output := SelfOrderItemOutput{
+				Name:        item.Name,
+				Size:        item.Size,
+				Quantity:    item.Quantity,
+				TotalPrice:  item.TotalPrice,
+				Observation: item.Observation,
+				Status:      item.Status,
+			}
+
+			for _, additional := range item.AdditionalItems {
+				output.Additionals = append(output.Additionals, additional.Name)
+			}
+
+			o.Items = append(o.Items, output)
+		}
+	}
+}
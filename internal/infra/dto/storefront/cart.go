@@ -0,0 +1,61 @@
+package storefrontdto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	productentity "github.com/willjrcom/sales-backend-go/internal/domain/product"
+	storefrontentity "github.com/willjrcom/sales-backend-go/internal/domain/storefront"
+)
+
+type AddCartItemInput struct {
+	storefrontentity.CartItem
+}
+
+type CartOutput struct {
+	ID    uuid.UUID        `json:"id"`
+	Items []CartItemOutput `json:"items"`
+	Total float64          `json:"total"`
+}
+
+// CartItemOutput shows the estimated price, the final price is resolved on checkout.
+type CartItemOutput struct {
+	storefrontentity.CartItem
+	Name  string  `json:"name"`
+	Price float64 `json:"price"`
+}
+
+// CartProduct is the product and the quantity chosen of an item or additional of the cart.
+type CartProduct struct {
+	Product  *productentity.Product
+	Quantity float64
+}
+
+func (c *CartOutput) FromModel(cart *storefrontentity.Cart, items map[uuid.UUID]CartProduct, additionals map[uuid.UUID][]CartProduct) {
+	c.ID = cart.ID
+	c.Items = []CartItemOutput{}
+	c.Total = 0
+
+	for _, item := range cart.Items {
+		output := CartItemOutput{CartItem: item}
+
+		if product, ok := items[item.ID]; ok {
+			output.Name = product.Product.Name
+			output.Price = product.Product.Price * product.Quantity
+		}
+
+		for _, additional := range additionals[item.ID] {
+			output.Price += additional.Product.Price * additional.Quantity
+		}
+
+		c.Total += output.Price
+		c.Items = append(c.Items, output)
+	}
+}
+
+type DeliveryFeeOutput struct {
+	AddressID     uuid.UUID     `json:"address_id"`
+	DeliveryTax   float64       `json:"delivery_tax"`
+	MinOrderValue float64       `json:"min_order_value"`
+	EstimatedTime time.Duration `json:"estimated_time"`
+}
@@ -0,0 +1,58 @@
+package storefrontdto
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+)
+
+var (
+	ErrCheckoutTypeInvalid    = errors.New("checkout type must be delivery or pickup")
+	ErrPaymentMethodInvalid   = errors.New("payment method is invalid")
+	ErrPaymentMethodNotOnline = errors.New("payment method is not available online")
+)
+
+type CheckoutType string
+
+const (
+	CheckoutTypeDelivery CheckoutType = "delivery"
+	CheckoutTypePickup   CheckoutType = "pickup"
+)
+
+type CheckoutInput struct {
+	Type          CheckoutType          `json:"type"`
+	PaymentMethod orderentity.PayMethod `json:"payment_method"`
+	Observation   string                `json:"observation"`
+}
+
+func (c *CheckoutInput) Validate() error {
+	if c.Type != CheckoutTypeDelivery && c.Type != CheckoutTypePickup {
+		return ErrCheckoutTypeInvalid
+	}
+
+	if c.PaymentMethod == orderentity.Dinheiro {
+		return ErrPaymentMethodNotOnline
+	}
+
+	for _, method := range orderentity.GetAllPayMethod() {
+		if method == c.PaymentMethod {
+			return nil
+		}
+	}
+
+	return ErrPaymentMethodInvalid
+}
+
+type CheckoutOutput struct {
+	OrderID       uuid.UUID  `json:"order_id"`
+	DeliveryID    *uuid.UUID `json:"delivery_id,omitempty"`
+	PickupID      *uuid.UUID `json:"pickup_id,omitempty"`
+	TotalPayable  float64    `json:"total_payable"`
+	DeliveryTax   float64    `json:"delivery_tax"`
+	TrackingToken string     `json:"tracking_token"`
+	// AwaitingPayment is true until the payment provider confirms the payment, the order is only prepared after it
+	AwaitingPayment bool `json:"awaiting_payment"`
+	// Eta is predicted as if the order was sent to the kitchen now, the tracking link refines it
+	Eta *orderentity.OrderEta `json:"eta,omitempty"`
+}
@@ -0,0 +1,107 @@
+package storefrontdto
+
+import (
+	"errors"
+	"strings"
+
+	addressentity "github.com/willjrcom/sales-backend-go/internal/domain/address"
+	cliententity "github.com/willjrcom/sales-backend-go/internal/domain/client"
+	personentity "github.com/willjrcom/sales-backend-go/internal/domain/person"
+	storefrontentity "github.com/willjrcom/sales-backend-go/internal/domain/storefront"
+)
+
+var (
+	ErrNameRequired    = errors.New("name is required")
+	ErrAddressRequired = errors.New("address is required")
+	ErrInvalidEmail    = errors.New("email is invalid")
+)
+
+// RegisterCustomerInput registers the client with the phone verified by otp.
+type RegisterCustomerInput struct {
+	Name    string                                 `json:"name"`
+	Email   string                                 `json:"email"`
+	Address *addressentity.AddressCommonAttributes `json:"address"`
+}
+
+func (r *RegisterCustomerInput) validate() error {
+	if r.Name == "" {
+		return ErrNameRequired
+	}
+
+	if r.Address == nil {
+		return ErrAddressRequired
+	}
+
+	if r.Email != "" && !strings.Contains(r.Email, "@") {
+		return ErrInvalidEmail
+	}
+
+	return nil
+}
+
+func (r *RegisterCustomerInput) ToModel(customer *storefrontentity.Customer) (*cliententity.Client, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	person := personentity.NewPerson(personentity.PersonCommonAttributes{
+		Name:  r.Name,
+		Email: r.Email,
+	})
+
+	contact := "(" + customer.Ddd + ") " + customer.Number
+	if err := person.AddContact(&contact, personentity.ContactTypeClient); err != nil {
+		return nil, err
+	}
+
+	// delivery tax is defined by the store, never by the customer
+	address := *r.Address
+	address.DeliveryTax = 0
+
+	if err := person.AddAddress(&address); err != nil {
+		return nil, err
+	}
+
+	return &cliententity.Client{
+		Person: *person,
+	}, nil
+}
+
+type UpdateCustomerAddressInput struct {
+	addressentity.PatchAddress
+}
+
+func (u *UpdateCustomerAddressInput) UpdateModel(address *addressentity.Address) error {
+	if u.Street != nil {
+		address.Street = *u.Street
+	}
+	if u.Number != nil {
+		address.Number = *u.Number
+	}
+	if u.Complement != nil {
+		address.Complement = *u.Complement
+	}
+	if u.Reference != nil {
+		address.Reference = *u.Reference
+	}
+	if u.Neighborhood != nil && *u.Neighborhood != address.Neighborhood {
+		address.Neighborhood = *u.Neighborhood
+		address.DeliveryTax = 0
+	}
+	if u.City != nil && *u.City != address.City {
+		address.City = *u.City
+		address.DeliveryTax = 0
+	}
+	if u.State != nil {
+		address.State = *u.State
+	}
+	if u.Cep != nil {
+		address.Cep = *u.Cep
+	}
+	if u.Latitude != nil && u.Longitude != nil {
+		address.Latitude = u.Latitude
+		address.Longitude = u.Longitude
+	}
+
+	return address.Validate()
+}
@@ -0,0 +1,41 @@
+package storefrontdto
+
+import (
+	"errors"
+
+	personentity "github.com/willjrcom/sales-backend-go/internal/domain/person"
+)
+
+var (
+	ErrCodeRequired = errors.New("code is required")
+)
+
+type RequestOtpInput struct {
+	Contact string `json:"contact"`
+}
+
+func (r *RequestOtpInput) ToModel() (ddd string, number string, err error) {
+	return personentity.ValidateAndExtractContact(r.Contact)
+}
+
+type VerifyOtpInput struct {
+	Contact string `json:"contact"`
+	Code    string `json:"code"`
+}
+
+func (v *VerifyOtpInput) ToModel() (ddd string, number string, code string, err error) {
+	if v.Code == "" {
+		return "", "", "", ErrCodeRequired
+	}
+
+	if ddd, number, err = personentity.ValidateAndExtractContact(v.Contact); err != nil {
+		return "", "", "", err
+	}
+
+	return ddd, number, v.Code, nil
+}
+
+type CustomerTokenOutput struct {
+	Token      string `json:"token"`
+	Registered bool   `json:"registered"`
+}
@@ -20,6 +20,14 @@ func (o *CreateTableInput) validate() error {
 		return ErrNameRequired
 	}
 
+	if o.SelfOrderMode == "" {
+		o.SelfOrderMode = tableentity.SelfOrderModeApproval
+	}
+
+	if err := tableentity.ValidateSelfOrderMode(o.SelfOrderMode); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -29,8 +37,9 @@ func (o *CreateTableInput) ToModel() (*tableentity.Table, error) {
 	}
 
 	tableCommonAttributes := tableentity.TableCommonAttributes{
-		Name:        o.Name,
-		IsAvailable: true,
+		Name:          o.Name,
+		IsAvailable:   true,
+		SelfOrderMode: o.SelfOrderMode,
 	}
 
 	table := &tableentity.Table{
@@ -38,5 +47,6 @@ func (o *CreateTableInput) ToModel() (*tableentity.Table, error) {
 		TableCommonAttributes: tableCommonAttributes,
 	}
 
+	table.RotatePublicToken()
 	return table, nil
 }
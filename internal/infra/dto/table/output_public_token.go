@@ -0,0 +1,8 @@
+package tabledto
+
+import "github.com/google/uuid"
+
+type PublicTokenOutput struct {
+	TableID uuid.UUID `json:"table_id"`
+	Token   string    `json:"token"`
+}
@@ -0,0 +1,18 @@
+package tabledto
+
+import (
+	tableentity "github.com/willjrcom/sales-backend-go/internal/domain/table"
+)
+
+type UpdateSelfOrderModeInput struct {
+	SelfOrderMode tableentity.SelfOrderMode `json:"self_order_mode"`
+}
+
+func (o *UpdateSelfOrderModeInput) UpdateModel(table *tableentity.Table) error {
+	if err := tableentity.ValidateSelfOrderMode(o.SelfOrderMode); err != nil {
+		return err
+	}
+
+	table.SelfOrderMode = o.SelfOrderMode
+	return nil
+}
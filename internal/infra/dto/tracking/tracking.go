@@ -0,0 +1,128 @@
+package trackingdto
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"time"
+
+	employeeentity "github.com/willjrcom/sales-backend-go/internal/domain/employee"
+	groupitementity "github.com/willjrcom/sales-backend-go/internal/domain/group_item"
+	itementity "github.com/willjrcom/sales-backend-go/internal/domain/item"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+)
+
+type OrderKind string
+
+const (
+	OrderKindDelivery OrderKind = "delivery"
+	OrderKindPickup   OrderKind = "pickup"
+	OrderKindTable    OrderKind = "table"
+)
+
+// TrackingOutput is the public view of the order, it must never carry data of the client or of the staff.
+type TrackingOutput struct {
+	OrderNumber int                        `json:"order_number"`
+	Kind        OrderKind                  `json:"kind"`
+	Status      orderentity.TrackingStatus `json:"status"`
+	Items       []TrackingItem             `json:"items"`
+	DeliveryTax float64                    `json:"delivery_tax,omitempty"`
+	Total       float64                    `json:"total"`
+	Timeline    []orderentity.TrackingStep `json:"timeline"`
+	DriverName  string                     `json:"driver_name,omitempty"`
+	EstimatedAt *time.Time                 `json:"estimated_at,omitempty"`
+	Eta         *orderentity.OrderEta      `json:"eta,omitempty"`
+	Done        bool                       `json:"done"`
+}
+
+type TrackingItem struct {
+	Name        string   `json:"name"`
+	Size        string   `json:"size"`
+	Quantity    float64  `json:"quantity"`
+	Observation string   `json:"observation,omitempty"`
+	Additionals []string `json:"additionals,omitempty"`
+}
+
+type TrackingTokenOutput struct {
+	Token string `json:"token"`
+}
+
+func (t *TrackingOutput) FromModel(order *orderentity.Order, driver *employeeentity.Employee) {
+	t.OrderNumber = order.OrderNumber
+	t.Total = order.TotalPayable
+	t.Timeline = order.TrackingTimeline()
+	t.Eta = order.Eta
+	t.Done = order.IsTrackingDone()
+	t.Items = []TrackingItem{}
+
+	switch {
+	case order.Delivery != nil:
+		t.Kind = OrderKindDelivery
+
+		if order.Delivery.DeliveryTax != nil {
+			t.DeliveryTax = *order.Delivery.DeliveryTax
+		}
+
+		// only the first name of the driver, after the order leaves the store
+		if driver != nil && order.Delivery.LaunchedAt != nil {
+			if names := strings.Fields(driver.Name); len(names) > 0 {
+				t.DriverName = names[0]
+			}
+		}
+	case order.Pickup != nil:
+		t.Kind = OrderKindPickup
+	default:
+		t.Kind = OrderKindTable
+	}
+
+	// the customer waits for the delivery or for the order ready to pick up
+	if t.Eta != nil {
+		t.EstimatedAt = t.Eta.ReadyAt
+		if t.Eta.DeliveredAt != nil {
+			t.EstimatedAt = t.Eta.DeliveredAt
+		}
+	}
+
+	t.Status = orderentity.TrackingStatusReceived
+	if len(t.Timeline) > 0 {
+		t.Status = t.Timeline[len(t.Timeline)-1].Status
+	}
+
+	for _, group := range order.Groups {
+		if group.Status == groupitementity.StatusGroupCanceled {
+			continue
+		}
+
+		for _, item := range group.Items {
+			if item.Status == itementity.StatusItemCanceled {
+				continue
+			}
+
+			trackingItem := TrackingItem{
+				Name:        item.Name,
+				Size:        item.Size,
+				Quantity:    item.Quantity,
+				Observation: item.Observation,
+			}
+
+			for _, additional := range item.AdditionalItems {
+				trackingItem.Additionals = append(trackingItem.Additionals, additional.Name)
+			}
+
+			t.Items = append(t.Items, trackingItem)
+		}
+	}
+}
+
+// Version changes when any field of the view changes, it is used to push only new states.
+func (t *TrackingOutput) Version() (string, error) {
+	content, err := json.Marshal(t)
+
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(content)
+	return hex.EncodeToString(hash[:16]), nil
+}
@@ -0,0 +1,59 @@
+package handlerimpl
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	"github.com/willjrcom/sales-backend-go/internal/infra/service/cep"
+	addressusecases "github.com/willjrcom/sales-backend-go/internal/usecases/address"
+	jsonpkg "github.com/willjrcom/sales-backend-go/pkg/json"
+)
+
+type handlerAddressImpl struct {
+	s *addressusecases.Service
+}
+
+func NewHandlerAddress(addressService *addressusecases.Service) *handler.Handler {
+	c := chi.NewRouter()
+
+	h := &handlerAddressImpl{
+		s: addressService,
+	}
+
+	c.With().Group(func(c chi.Router) {
+		c.Get("/cep/{cep}", h.handlerGetAddressByCep)
+	})
+
+	return handler.NewHandler("/address", c)
+}
+
+func (h *handlerAddressImpl) handlerGetAddressByCep(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	cepString := chi.URLParam(r, "cep")
+
+	if _, err := cep.NormalizeCep(cepString); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	address, err := h.s.GetAddressByCep(ctx, cepString)
+	if errors.Is(err, cep.ErrCepNotFound) {
+		jsonpkg.ResponseJson(w, r, http.StatusNotFound, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	if errors.Is(err, cep.ErrProviderUnavailable) {
+		jsonpkg.ResponseJson(w, r, http.StatusServiceUnavailable, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: address})
+}
@@ -0,0 +1,112 @@
+package handlerimpl
+
+import (
+	"bytes"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	catalogdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/catalog"
+	catalogusecases "github.com/willjrcom/sales-backend-go/internal/usecases/catalog"
+	jsonpkg "github.com/willjrcom/sales-backend-go/pkg/json"
+)
+
+type handlerCatalogImpl struct {
+	s *catalogusecases.Service
+}
+
+func NewHandlerCatalog(catalogService *catalogusecases.Service) *handler.Handler {
+	c := chi.NewRouter()
+
+	h := &handlerCatalogImpl{
+		s: catalogService,
+	}
+
+	c.With().Group(func(c chi.Router) {
+		c.Get("/export/{format}", h.handlerExportCatalog)
+		c.Post("/import/{format}", h.handlerImportCatalog)
+		c.Post("/import/{format}/dry-run", h.handlerImportCatalogDryRun)
+	})
+
+	return handler.NewHandler("/catalog", c)
+}
+
+func (h *handlerCatalogImpl) handlerExportCatalog(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	format := chi.URLParam(r, "format")
+
+	if format != "json" && format != "csv" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: catalogusecases.ErrFormatInvalid.Error()})
+		return
+	}
+
+	catalog, err := h.s.ExportCatalog(ctx)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	if format == "json" {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: catalog})
+		return
+	}
+
+	buffer := &bytes.Buffer{}
+	if err := catalog.WriteCSV(buffer); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", "attachment; filename=catalog.csv")
+	w.WriteHeader(http.StatusOK)
+	w.Write(buffer.Bytes())
+}
+
+func (h *handlerCatalogImpl) handlerImportCatalog(w http.ResponseWriter, r *http.Request) {
+	h.importCatalog(w, r, false)
+}
+
+func (h *handlerCatalogImpl) handlerImportCatalogDryRun(w http.ResponseWriter, r *http.Request) {
+	h.importCatalog(w, r, true)
+}
+
+func (h *handlerCatalogImpl) importCatalog(w http.ResponseWriter, r *http.Request, dryRun bool) {
+	ctx := r.Context()
+
+	report := catalogdto.NewImportReport(dryRun)
+	catalog := &catalogdto.Catalog{}
+
+	switch chi.URLParam(r, "format") {
+	case "json":
+		if err := jsonpkg.ParseBody(r, catalog); err != nil {
+			jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: err.Error()})
+			return
+		}
+	case "csv":
+		csvCatalog, err := catalogdto.ReadCSV(r.Body, report)
+		if err != nil {
+			jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: err.Error()})
+			return
+		}
+
+		catalog = csvCatalog
+	default:
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: catalogusecases.ErrFormatInvalid.Error()})
+		return
+	}
+
+	report, err := h.s.ImportCatalog(ctx, catalog, report)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	if report.HasErrors() {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.HTTPResponse{Data: report})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: report})
+}
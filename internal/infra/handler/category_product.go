@@ -30,6 +30,7 @@ func NewHandlerCategoryProduct(categoryService *categoryproductusecases.Service)
 	c.With().Group(func(c chi.Router) {
 		c.Post("/new", h.handlerRegisterCategoryProduct)
 		c.Patch("/update/{id}", h.handlerUpdateCategoryProduct)
+		c.Post("/{id}/clone", h.handlerCloneCategoryProduct)
 		c.Delete("/{id}", h.handlerDeleteCategoryProduct)
 		c.Get("/{id}", h.handlerGetCategoryProduct)
 		c.Get("/all", h.handlerGetAllCategories)
@@ -134,3 +135,30 @@ func (h *handlerCategoryProductImpl) handlerGetAllCategories(w http.ResponseWrit
 
 	jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: categories})
 }
+
+func (h *handlerCategoryProductImpl) handlerCloneCategoryProduct(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	dtoClone := &categorydto.CloneCategoryInput{}
+	if err := jsonpkg.ParseBody(r, dtoClone); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	cloneID, err := h.s.CloneCategory(ctx, dtoId, dtoClone)
+
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusCreated, jsonpkg.HTTPResponse{Data: cloneID})
+}
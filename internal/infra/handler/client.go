@@ -1,12 +1,17 @@
 package handlerimpl
 
 import (
+	"bytes"
+	"fmt"
 	"net/http"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
 	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	cliententity "github.com/willjrcom/sales-backend-go/internal/domain/client"
+	addressdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/address"
 	clientdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/client"
+	contactdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/contact"
 	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
 	keysdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/keys"
 	clientusecases "github.com/willjrcom/sales-backend-go/internal/usecases/client"
@@ -33,6 +38,18 @@ func NewHandlerClient(clientService *clientusecases.Service) *handler.Handler {
 		c.Get("/{id}", h.handlerGetClientById)
 		c.Post("/by-contact", h.handlerGetClientByContact)
 		c.Get("/all", h.handlerGetAllClients)
+		c.Get("/{id}/profile", h.handlerGetClientProfile)
+		c.Get("/rfm", h.handlerGetRFM)
+		c.Get("/rfm/{segment}/export", h.handlerExportSegment)
+		c.Get("/duplicates", h.handlerFindDuplicateClients)
+		c.Post("/merge", h.handlerMergeClients)
+		c.Get("/merges", h.handlerGetAllClientMerges)
+		c.Post("/{id}/address", h.handlerRegisterAddressToClient)
+		c.Put("/{id}/address/{address_id}/default", h.handlerSetDefaultAddress)
+		c.Delete("/{id}/address/{address_id}", h.handlerDeleteAddressFromClient)
+		c.Post("/{id}/contact", h.handlerRegisterContactToClient)
+		c.Patch("/contact/{id}", h.handlerUpdateContact)
+		c.Delete("/contact/{id}", h.handlerDeleteContact)
 	})
 
 	unprotectedRoutes := []string{}
@@ -155,3 +172,258 @@ func (h *handlerClientImpl) handlerGetAllClients(w http.ResponseWriter, r *http.
 
 	jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: categories})
 }
+
+func (h *handlerClientImpl) handlerGetClientProfile(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	profile, err := h.s.GetClientProfile(ctx, dtoId)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: profile})
+}
+
+func (h *handlerClientImpl) handlerGetRFM(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	rfm, err := h.s.GetRFM(ctx)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: rfm})
+}
+
+func (h *handlerClientImpl) handlerExportSegment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	segment := cliententity.Segment(chi.URLParam(r, "segment"))
+
+	if err := cliententity.ValidateSegment(segment); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	rfm, err := h.s.ExportSegment(ctx, segment)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	buffer := &bytes.Buffer{}
+	if err := rfm.WriteCSV(buffer); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=clients_%s.csv", segment))
+	w.WriteHeader(http.StatusOK)
+	w.Write(buffer.Bytes())
+}
+
+func (h *handlerClientImpl) handlerRegisterAddressToClient(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	dtoAddress := &addressdto.RegisterAddressInput{}
+	if err := jsonpkg.ParseBody(r, dtoAddress); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	addressID, err := h.s.RegisterAddressToClient(ctx, dtoId, dtoAddress)
+
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusCreated, jsonpkg.HTTPResponse{Data: addressID})
+}
+
+func (h *handlerClientImpl) handlerSetDefaultAddress(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+	addressID := chi.URLParam(r, "address_id")
+
+	if id == "" || addressID == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id and address_id are required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+	dtoAddressId := &entitydto.IdRequest{ID: uuid.MustParse(addressID)}
+
+	if err := h.s.SetDefaultAddress(ctx, dtoId, dtoAddressId); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+}
+
+func (h *handlerClientImpl) handlerDeleteAddressFromClient(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+	addressID := chi.URLParam(r, "address_id")
+
+	if id == "" || addressID == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id and address_id are required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+	dtoAddressId := &entitydto.IdRequest{ID: uuid.MustParse(addressID)}
+
+	if err := h.s.DeleteAddressFromClient(ctx, dtoId, dtoAddressId); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+}
+
+func (h *handlerClientImpl) handlerRegisterContactToClient(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoContact := &contactdto.RegisterContactInput{}
+	if err := jsonpkg.ParseBody(r, dtoContact); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	dtoContact.ClientID = uuid.MustParse(id)
+	dtoContact.EmployeeID = uuid.Nil
+
+	contactID, err := h.s.RegisterContactToClient(ctx, dtoContact)
+
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusCreated, jsonpkg.HTTPResponse{Data: contactID})
+}
+
+func (h *handlerClientImpl) handlerUpdateContact(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	dtoContact := &contactdto.UpdateContactInput{}
+	if err := jsonpkg.ParseBody(r, dtoContact); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	if err := h.s.UpdateContact(ctx, dtoId, dtoContact); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+}
+
+func (h *handlerClientImpl) handlerDeleteContact(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	if err := h.s.DeleteContact(ctx, dtoId); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+}
+
+func (h *handlerClientImpl) handlerFindDuplicateClients(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	duplicates, err := h.s.FindDuplicateClients(ctx)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: duplicates})
+}
+
+func (h *handlerClientImpl) handlerMergeClients(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	dtoMerge := &clientdto.MergeClientsInput{}
+	if err := jsonpkg.ParseBody(r, dtoMerge); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	if err := dtoMerge.Validate(); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	client, err := h.s.MergeClients(ctx, dtoMerge)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: client})
+}
+
+func (h *handlerClientImpl) handlerGetAllClientMerges(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	merges, err := h.s.GetAllClientMerges(ctx)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: merges})
+}
@@ -1,6 +1,7 @@
 package handlerimpl
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/go-chi/chi/v5"
@@ -25,10 +26,18 @@ func NewHandlerDeliveryOrder(orderService deliveryorderusecases.IService) *handl
 		c.Post("/new", h.handlerRegisterDeliveryOrder)
 		c.Get("/{id}", h.handlerGetDeliveryById)
 		c.Get("/all", h.handlerGetAllDeliveries)
+		c.Post("/all-by-status", h.handlerGetDeliveriesByStatus)
 		c.Post("/update/launch/{id}", h.handlerLaunchDeliveryOrder)
 		c.Post("/update/finish/{id}", h.handlerFinishDeliveryOrder)
+		c.Post("/update/ready/{id}", h.handlerReadyDeliveryOrder)
+		c.Post("/update/fail/{id}", h.handlerFailDeliveryOrder)
+		c.Post("/update/return/{id}", h.handlerReturnDeliveryOrder)
+		c.Post("/update/cancel/{id}", h.handlerCancelDeliveryOrder)
 		c.Put("/update/driver/{id}", h.handlerUpdateDriver)
 		c.Put("/update/address/{id}", h.handlerUpdateDeliveryAddress)
+		c.Get("/dispatch", h.handlerGetDispatchBoard)
+		c.Post("/dispatch/launch", h.handlerLaunchDeliveryBatch)
+		c.Get("/driver/{id}", h.handlerGetDeliveriesByDriver)
 	})
 
 	return handler.NewHandler("/delivery-order", c)
@@ -85,6 +94,29 @@ func (h *handlerDeliveryOrderImpl) handlerGetAllDeliveries(w http.ResponseWriter
 	jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: orders})
 }
 
+func (h *handlerDeliveryOrderImpl) handlerGetDeliveriesByStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	dtoStatus := &deliveryorderdto.DeliveryOrderByStatusInput{}
+	if err := jsonpkg.ParseBody(r, dtoStatus); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	deliveries, err := h.IService.GetDeliveryOrderByStatus(ctx, dtoStatus)
+	if errors.Is(err, deliveryorderdto.ErrDeliveryStatusInvalid) {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: deliveries})
+}
+
 func (h *handlerDeliveryOrderImpl) handlerLaunchDeliveryOrder(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -131,6 +163,98 @@ func (h *handlerDeliveryOrderImpl) handlerFinishDeliveryOrder(w http.ResponseWri
 	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
 }
 
+func (h *handlerDeliveryOrderImpl) handlerReadyDeliveryOrder(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	if err := h.IService.ReadyDeliveryOrder(ctx, dtoId); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+}
+
+func (h *handlerDeliveryOrderImpl) handlerFailDeliveryOrder(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	dtoReason := &deliveryorderdto.DeliveryReasonInput{}
+	if err := jsonpkg.ParseBody(r, dtoReason); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	if err := h.IService.FailDeliveryOrder(ctx, dtoId, dtoReason); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+}
+
+func (h *handlerDeliveryOrderImpl) handlerReturnDeliveryOrder(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	if err := h.IService.ReturnDeliveryOrder(ctx, dtoId); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+}
+
+func (h *handlerDeliveryOrderImpl) handlerCancelDeliveryOrder(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	dtoReason := &deliveryorderdto.DeliveryReasonInput{}
+	if err := jsonpkg.ParseBody(r, dtoReason); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	if err := h.IService.CancelDeliveryOrder(ctx, dtoId, dtoReason); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+}
+
 func (h *handlerDeliveryOrderImpl) handlerUpdateDeliveryAddress(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -143,7 +267,13 @@ func (h *handlerDeliveryOrderImpl) handlerUpdateDeliveryAddress(w http.ResponseW
 
 	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
 
-	if err := h.IService.UpdateDeliveryAddress(ctx, dtoId); err != nil {
+	dtoAddress := &deliveryorderdto.UpdateDeliveryOrder{}
+	if err := jsonpkg.ParseBody(r, dtoAddress); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	if err := h.IService.UpdateDeliveryAddress(ctx, dtoId, dtoAddress); err != nil {
 		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
 		return
 	}
@@ -176,3 +306,59 @@ func (h *handlerDeliveryOrderImpl) handlerUpdateDriver(w http.ResponseWriter, r
 
 	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
 }
+
+func (h *handlerDeliveryOrderImpl) handlerGetDispatchBoard(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	board, err := h.IService.GetDispatchBoard(ctx)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: board})
+}
+
+func (h *handlerDeliveryOrderImpl) handlerLaunchDeliveryBatch(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	dtoBatch := &deliveryorderdto.LaunchDeliveryBatchInput{}
+	if err := jsonpkg.ParseBody(r, dtoBatch); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	if err := dtoBatch.Validate(); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	batch, err := h.IService.LaunchDeliveryBatch(ctx, dtoBatch)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: batch})
+}
+
+func (h *handlerDeliveryOrderImpl) handlerGetDeliveriesByDriver(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	deliveries, err := h.IService.GetDeliveryOrderByDriverId(ctx, dtoId)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: deliveries})
+}
@@ -0,0 +1,154 @@
+package handlerimpl
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	deliveryzonedto "github.com/willjrcom/sales-backend-go/internal/infra/dto/delivery_zone"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	deliveryzoneusecases "github.com/willjrcom/sales-backend-go/internal/usecases/delivery_zone"
+	jsonpkg "github.com/willjrcom/sales-backend-go/pkg/json"
+)
+
+type handlerDeliveryZoneImpl struct {
+	s *deliveryzoneusecases.Service
+}
+
+func NewHandlerDeliveryZone(deliveryZoneService *deliveryzoneusecases.Service) *handler.Handler {
+	c := chi.NewRouter()
+
+	h := &handlerDeliveryZoneImpl{
+		s: deliveryZoneService,
+	}
+
+	c.With().Group(func(c chi.Router) {
+		c.Post("/new", h.handlerRegisterDeliveryZone)
+		c.Patch("/update/{id}", h.handlerUpdateDeliveryZone)
+		c.Delete("/{id}", h.handlerDeleteDeliveryZone)
+		c.Get("/{id}", h.handlerGetDeliveryZone)
+		c.Get("/all", h.handlerGetAllDeliveryZones)
+		c.Get("/quote/{address_id}", h.handlerGetQuote)
+	})
+
+	return handler.NewHandler("/delivery-zone", c)
+}
+
+func (h *handlerDeliveryZoneImpl) handlerRegisterDeliveryZone(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	dtoDeliveryZone := &deliveryzonedto.RegisterDeliveryZoneInput{}
+	if err := jsonpkg.ParseBody(r, dtoDeliveryZone); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	id, err := h.s.RegisterDeliveryZone(ctx, dtoDeliveryZone)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusCreated, jsonpkg.HTTPResponse{Data: id})
+}
+
+func (h *handlerDeliveryZoneImpl) handlerUpdateDeliveryZone(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	dtoDeliveryZone := &deliveryzonedto.UpdateDeliveryZoneInput{}
+	if err := jsonpkg.ParseBody(r, dtoDeliveryZone); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	if err := h.s.UpdateDeliveryZone(ctx, dtoId, dtoDeliveryZone); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+}
+
+func (h *handlerDeliveryZoneImpl) handlerDeleteDeliveryZone(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	if err := h.s.DeleteDeliveryZone(ctx, dtoId); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+}
+
+func (h *handlerDeliveryZoneImpl) handlerGetDeliveryZone(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	deliveryZone, err := h.s.GetDeliveryZoneById(ctx, dtoId)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: deliveryZone})
+}
+
+func (h *handlerDeliveryZoneImpl) handlerGetAllDeliveryZones(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	deliveryZones, err := h.s.GetAllDeliveryZones(ctx)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: deliveryZones})
+}
+
+func (h *handlerDeliveryZoneImpl) handlerGetQuote(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "address_id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "address_id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	quote, err := h.s.GetQuoteByAddressId(ctx, dtoId)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: quote})
+}
@@ -0,0 +1,198 @@
+package handlerimpl
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+	deliveryorderdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/delivery"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	deliveryorderusecases "github.com/willjrcom/sales-backend-go/internal/usecases/delivery_order"
+	jsonpkg "github.com/willjrcom/sales-backend-go/pkg/json"
+)
+
+const maxPhotoSize = 10 << 20
+
+type handlerDriverImpl struct {
+	s deliveryorderusecases.IService
+}
+
+// NewHandlerDriver is the api of the drivers, every route acts on the deliveries of the logged employee.
+func NewHandlerDriver(deliveryOrderService deliveryorderusecases.IService) *handler.Handler {
+	c := chi.NewRouter()
+
+	h := &handlerDriverImpl{
+		s: deliveryOrderService,
+	}
+
+	c.With().Group(func(c chi.Router) {
+		c.Get("/deliveries", h.handlerGetMyDeliveries)
+		c.Post("/deliveries/{id}/accept", h.handlerAcceptDelivery)
+		c.Post("/deliveries/{id}/pickup", h.handlerPickupDelivery)
+		c.Post("/deliveries/{id}/photo", h.handlerUploadDeliveryPhoto)
+		c.Post("/deliveries/{id}/finish", h.handlerFinishDelivery)
+		c.Post("/deliveries/{id}/fail", h.handlerFailDelivery)
+	})
+
+	return handler.NewHandler("/driver", c)
+}
+
+func (h *handlerDriverImpl) handlerGetMyDeliveries(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	deliveries, err := h.s.GetMyDeliveries(ctx)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, driverErrorStatus(err), jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: deliveries})
+}
+
+func (h *handlerDriverImpl) handlerAcceptDelivery(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	if err := h.s.AcceptMyDelivery(ctx, dtoId); err != nil {
+		jsonpkg.ResponseJson(w, r, driverErrorStatus(err), jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+}
+
+func (h *handlerDriverImpl) handlerPickupDelivery(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	if err := h.s.PickupMyDelivery(ctx, dtoId); err != nil {
+		jsonpkg.ResponseJson(w, r, driverErrorStatus(err), jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+}
+
+func (h *handlerDriverImpl) handlerUploadDeliveryPhoto(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	if err := r.ParseMultipartForm(maxPhotoSize); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	photo, _, err := r.FormFile("photo")
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	defer photo.Close()
+
+	key, err := h.s.UploadMyDeliveryPhoto(ctx, dtoId, photo)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, driverErrorStatus(err), jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: key})
+}
+
+func (h *handlerDriverImpl) handlerFinishDelivery(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	dtoDeliver := &deliveryorderdto.DeliverOrderInput{}
+	if err := jsonpkg.ParseBody(r, dtoDeliver); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	if err := h.s.FinishMyDelivery(ctx, dtoId, dtoDeliver); err != nil {
+		jsonpkg.ResponseJson(w, r, driverErrorStatus(err), jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+}
+
+func (h *handlerDriverImpl) handlerFailDelivery(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	dtoReason := &deliveryorderdto.DeliveryReasonInput{}
+	if err := jsonpkg.ParseBody(r, dtoReason); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	if err := h.s.FailMyDelivery(ctx, dtoId, dtoReason); err != nil {
+		jsonpkg.ResponseJson(w, r, driverErrorStatus(err), jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+}
+
+// driverErrorStatus maps the errors of the driver routes to the status codes the driver app handles.
+func driverErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return http.StatusNotFound
+	case errors.Is(err, deliveryorderusecases.ErrDriverNotLogged), errors.Is(err, orderentity.ErrDeliveryNotFromDriver):
+		return http.StatusForbidden
+	case errors.Is(err, orderentity.ErrDeliveryAlreadyAccepted), errors.Is(err, orderentity.ErrDeliveryAlreadyPickedUp),
+		errors.Is(err, orderentity.ErrDeliveryAlreadyDelivered), errors.Is(err, orderentity.ErrDeliveryNotLaunched),
+		errors.Is(err, orderentity.ErrDeliveryTransition):
+		return http.StatusConflict
+	case errors.Is(err, orderentity.ErrDeliveryReasonRequired):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
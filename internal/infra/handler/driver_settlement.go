@@ -0,0 +1,128 @@
+package handlerimpl
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	driversettlementdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/driver_settlement"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	driversettlementusecases "github.com/willjrcom/sales-backend-go/internal/usecases/driver_settlement"
+	jsonpkg "github.com/willjrcom/sales-backend-go/pkg/json"
+)
+
+type handlerDriverSettlementImpl struct {
+	s *driversettlementusecases.Service
+}
+
+func NewHandlerDriverSettlement(driverSettlementService *driversettlementusecases.Service) *handler.Handler {
+	c := chi.NewRouter()
+
+	h := &handlerDriverSettlementImpl{
+		s: driverSettlementService,
+	}
+
+	c.With().Group(func(c chi.Router) {
+		c.Post("/new", h.handlerRegisterDriverSettlement)
+		c.Post("/{id}/refresh", h.handlerRefreshDriverSettlement)
+		c.Post("/{id}/close", h.handlerCloseDriverSettlement)
+		c.Get("/{id}", h.handlerGetDriverSettlement)
+		c.Get("/all", h.handlerGetAllDriverSettlements)
+	})
+
+	return handler.NewHandler("/driver-settlement", c)
+}
+
+func (h *handlerDriverSettlementImpl) handlerRegisterDriverSettlement(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	dtoDriverSettlement := &driversettlementdto.RegisterDriverSettlementInput{}
+	if err := jsonpkg.ParseBody(r, dtoDriverSettlement); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	id, err := h.s.RegisterDriverSettlement(ctx, dtoDriverSettlement)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusCreated, jsonpkg.HTTPResponse{Data: id})
+}
+
+func (h *handlerDriverSettlementImpl) handlerRefreshDriverSettlement(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	driverSettlement, err := h.s.RefreshDriverSettlement(ctx, dtoId)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: driverSettlement})
+}
+
+func (h *handlerDriverSettlementImpl) handlerCloseDriverSettlement(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	driverSettlement, err := h.s.CloseDriverSettlement(ctx, dtoId)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: driverSettlement})
+}
+
+func (h *handlerDriverSettlementImpl) handlerGetDriverSettlement(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	driverSettlement, err := h.s.GetDriverSettlementById(ctx, dtoId)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: driverSettlement})
+}
+
+func (h *handlerDriverSettlementImpl) handlerGetAllDriverSettlements(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	driverSettlements, err := h.s.GetAllDriverSettlements(ctx)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: driverSettlements})
+}
@@ -27,6 +27,7 @@ func NewHandlerItem(itemService *itemusecases.Service) *handler.Handler {
 
 	c.With().Group(func(c chi.Router) {
 		c.Post("/add", h.handlerAddItem)
+		c.Post("/add-combo", h.handlerAddCombo)
 		c.Post("/start/{id}", h.handlerStartItemByID)
 		c.Post("/ready/{id}", h.handlerReadyItemByID)
 		c.Post("/cancel/{id}", h.handlerCancelItemByID)
@@ -57,6 +58,24 @@ func (h *handlerItemImpl) handlerAddItem(w http.ResponseWriter, r *http.Request)
 	jsonpkg.ResponseJson(w, r, http.StatusCreated, jsonpkg.HTTPResponse{Data: ids})
 }
 
+func (h *handlerItemImpl) handlerAddCombo(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	dtoAddCombo := &itemdto.AddComboOrderInput{}
+	if err := jsonpkg.ParseBody(r, dtoAddCombo); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	combo, err := h.s.AddComboOrder(ctx, dtoAddCombo)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusCreated, jsonpkg.HTTPResponse{Data: combo})
+}
+
 func (h *handlerItemImpl) handlerStartItemByID(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -0,0 +1,165 @@
+package handlerimpl
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	loyaltydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/loyalty"
+	loyaltyusecases "github.com/willjrcom/sales-backend-go/internal/usecases/loyalty"
+	jsonpkg "github.com/willjrcom/sales-backend-go/pkg/json"
+)
+
+type handlerLoyaltyImpl struct {
+	s *loyaltyusecases.Service
+}
+
+func NewHandlerLoyalty(loyaltyService *loyaltyusecases.Service) *handler.Handler {
+	c := chi.NewRouter()
+
+	h := &handlerLoyaltyImpl{
+		s: loyaltyService,
+	}
+
+	c.With().Group(func(c chi.Router) {
+		c.Get("/program", h.handlerGetProgram)
+		c.Put("/program", h.handlerUpdateProgram)
+		c.Post("/reward/new", h.handlerRegisterReward)
+		c.Patch("/reward/update/{id}", h.handlerUpdateReward)
+		c.Get("/reward/all", h.handlerGetAllRewards)
+		c.Get("/client/{id}/statement", h.handlerGetStatement)
+		c.Post("/redeem", h.handlerRedeemReward)
+		c.Post("/order/{id}/reverse", h.handlerReverseOrder)
+	})
+
+	return handler.NewHandler("/loyalty", c)
+}
+
+func (h *handlerLoyaltyImpl) handlerGetProgram(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	program, err := h.s.GetProgram(ctx)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: program})
+}
+
+func (h *handlerLoyaltyImpl) handlerUpdateProgram(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	dtoProgram := &loyaltydto.UpdateProgramInput{}
+	if err := jsonpkg.ParseBody(r, dtoProgram); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	program, err := h.s.UpdateProgram(ctx, dtoProgram)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: program})
+}
+
+func (h *handlerLoyaltyImpl) handlerRegisterReward(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	dtoReward := &loyaltydto.RegisterRewardInput{}
+	if err := jsonpkg.ParseBody(r, dtoReward); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	id, err := h.s.RegisterReward(ctx, dtoReward)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusCreated, jsonpkg.HTTPResponse{Data: id})
+}
+
+func (h *handlerLoyaltyImpl) handlerUpdateReward(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := chi.URLParam(r, "id")
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	dtoReward := &loyaltydto.UpdateRewardInput{}
+	if err := jsonpkg.ParseBody(r, dtoReward); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	if err := h.s.UpdateReward(ctx, dtoId, dtoReward); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+}
+
+func (h *handlerLoyaltyImpl) handlerGetAllRewards(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	rewards, err := h.s.GetAllRewards(ctx)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: rewards})
+}
+
+func (h *handlerLoyaltyImpl) handlerGetStatement(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := chi.URLParam(r, "id")
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	statement, err := h.s.GetStatement(ctx, dtoId)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: statement})
+}
+
+func (h *handlerLoyaltyImpl) handlerRedeemReward(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	dtoRedeem := &loyaltydto.RedeemRewardInput{}
+	if err := jsonpkg.ParseBody(r, dtoRedeem); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	statement, err := h.s.RedeemReward(ctx, dtoRedeem)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusCreated, jsonpkg.HTTPResponse{Data: statement})
+}
+
+func (h *handlerLoyaltyImpl) handlerReverseOrder(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := chi.URLParam(r, "id")
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	if err := h.s.ReverseOrder(ctx, dtoId); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+}
@@ -0,0 +1,62 @@
+package handlerimpl
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	menuusecases "github.com/willjrcom/sales-backend-go/internal/usecases/menu"
+	jsonpkg "github.com/willjrcom/sales-backend-go/pkg/json"
+)
+
+type handlerMenuImpl struct {
+	s *menuusecases.Service
+}
+
+func NewHandlerMenu(menuService *menuusecases.Service) *handler.Handler {
+	c := chi.NewRouter()
+
+	h := &handlerMenuImpl{
+		s: menuService,
+	}
+
+	route := "/menu"
+
+	c.With().Group(func(c chi.Router) {
+		c.Get("/{slug}", h.handlerGetPublicMenu)
+	})
+
+	unprotectedRoutes := []string{
+		fmt.Sprintf("%s/", route),
+	}
+
+	return handler.NewHandler(route, c, unprotectedRoutes...)
+}
+
+func (h *handlerMenuImpl) handlerGetPublicMenu(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	slug := chi.URLParam(r, "slug")
+
+	menu, err := h.s.GetPublicMenu(ctx, slug)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusNotFound, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	etag, err := menu.ETag()
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", "public, max-age=60")
+
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: menu})
+}
@@ -27,10 +27,12 @@ func NewHandlerOrder(orderService *orderusecases.Service) *handler.Handler {
 		//c.Post("/new", h.handlerCreateOrder)
 		c.Get("/{id}", h.handlerGetOrderById)
 		c.Get("/all", h.handlerGetAllOrders)
+		c.Get("/{id}/tracking-token", h.handlerGetTrackingToken)
 		c.Put("/update/{id}/observation", h.handlerUpdateObservation)
 		c.Put("/update/{id}/payment", h.handlerUpdatePaymentMethod)
 		c.Put("/update/{id}/schedule", h.handlerScheduleOrder)
 		c.Post("/pending/{id}", h.handlerPendingOrder)
+		c.Post("/confirm-payment/{id}", h.handlerConfirmOnlinePayment)
 		c.Post("/finish/{id}", h.handlerFinishOrder)
 		c.Post("/cancel/{id}", h.handlerCancelOrder)
 		c.Post("/archive/{id}", h.handlerArchiveOrder)
@@ -91,6 +93,21 @@ func (h *handlerOrderImpl) handlerGetAllOrders(w http.ResponseWriter, r *http.Re
 	jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: orders})
 }
 
+func (h *handlerOrderImpl) handlerGetTrackingToken(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := chi.URLParam(r, "id")
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	token, err := h.s.GetTrackingToken(ctx, dtoId)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: token})
+}
+
 func (h *handlerOrderImpl) handlerUpdateObservation(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -163,6 +180,26 @@ func (h *handlerOrderImpl) handlerPendingOrder(w http.ResponseWriter, r *http.Re
 	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
 }
 
+func (h *handlerOrderImpl) handlerConfirmOnlinePayment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	if err := h.s.ConfirmOnlinePayment(ctx, dtoId); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+}
+
 func (h *handlerOrderImpl) handlerFinishOrder(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -0,0 +1,132 @@
+package handlerimpl
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	pricelistdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/price_list"
+	pricelistusecases "github.com/willjrcom/sales-backend-go/internal/usecases/price_list"
+	jsonpkg "github.com/willjrcom/sales-backend-go/pkg/json"
+)
+
+type handlerPriceListImpl struct {
+	s *pricelistusecases.Service
+}
+
+func NewHandlerPriceList(priceListService *pricelistusecases.Service) *handler.Handler {
+	c := chi.NewRouter()
+
+	h := &handlerPriceListImpl{
+		s: priceListService,
+	}
+
+	c.With().Group(func(c chi.Router) {
+		c.Post("/new", h.handlerRegisterPriceList)
+		c.Patch("/update/{id}", h.handlerUpdatePriceList)
+		c.Delete("/{id}", h.handlerDeletePriceList)
+		c.Get("/{id}", h.handlerGetPriceList)
+		c.Get("/all", h.handlerGetAllPriceLists)
+	})
+
+	return handler.NewHandler("/price-list", c)
+}
+
+func (h *handlerPriceListImpl) handlerRegisterPriceList(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	dtoPriceList := &pricelistdto.RegisterPriceListInput{}
+	if err := jsonpkg.ParseBody(r, dtoPriceList); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	id, err := h.s.RegisterPriceList(ctx, dtoPriceList)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusCreated, jsonpkg.HTTPResponse{Data: id})
+}
+
+func (h *handlerPriceListImpl) handlerUpdatePriceList(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	dtoPriceList := &pricelistdto.UpdatePriceListInput{}
+	if err := jsonpkg.ParseBody(r, dtoPriceList); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	if err := h.s.UpdatePriceList(ctx, dtoId, dtoPriceList); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+}
+
+func (h *handlerPriceListImpl) handlerDeletePriceList(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	if err := h.s.DeletePriceList(ctx, dtoId); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+}
+
+func (h *handlerPriceListImpl) handlerGetPriceList(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	priceList, err := h.s.GetPriceListById(ctx, dtoId)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: priceList})
+}
+
+func (h *handlerPriceListImpl) handlerGetAllPriceLists(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	priceLists, err := h.s.GetAllPriceLists(ctx)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: priceLists})
+}
@@ -25,11 +25,20 @@ func NewHandlerProduct(productService *productusecases.Service) *handler.Handler
 
 	c.With().Group(func(c chi.Router) {
 		c.Post("/new", h.handlerRegisterProduct)
+		c.Post("/merge-variants", h.handlerMergeVariants)
 		c.Patch("/update/{id}", h.handlerUpdateProduct)
 		c.Delete("/{id}", h.handlerDeleteProduct)
 		c.Get("/{id}", h.handlerGetProduct)
 		c.Get("/code/{code}", h.handlerGetProductByCode)
 		c.Get("/all", h.handlerGetAllProducts)
+		c.Get("/available", h.handlerGetAvailableProducts)
+		c.Post("/{id}/schedule-price", h.handlerSchedulePriceChange)
+		c.Post("/bulk/price", h.handlerBulkAdjustPrices)
+		c.Post("/bulk/price/preview", h.handlerBulkAdjustPricesPreview)
+		c.Post("/bulk/availability", h.handlerBulkSetAvailability)
+		c.Post("/bulk/availability/preview", h.handlerBulkSetAvailabilityPreview)
+		c.Get("/bulk/batches", h.handlerGetAllProductBatches)
+		c.Get("/{id}/price-history", h.handlerGetPriceHistory)
 	})
 
 	return handler.NewHandler("/product", c)
@@ -65,6 +74,24 @@ func (h *handlerProductImpl) handlerRegisterProduct(w http.ResponseWriter, r *ht
 	jsonpkg.ResponseJson(w, r, http.StatusCreated, jsonpkg.HTTPResponse{Data: id})
 }
 
+func (h *handlerProductImpl) handlerMergeVariants(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	dtoMerge := &productdto.MergeVariantsInput{}
+	if err := jsonpkg.ParseBody(r, dtoMerge); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	id, err := h.s.MergeProductsIntoVariants(ctx, dtoMerge)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: id})
+}
+
 func (h *handlerProductImpl) handlerUpdateProduct(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -164,3 +191,127 @@ func (h *handlerProductImpl) handlerGetAllProducts(w http.ResponseWriter, r *htt
 
 	jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: categories})
 }
+
+func (h *handlerProductImpl) handlerGetAvailableProducts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	products, err := h.s.GetAvailableProducts(ctx)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: products})
+}
+
+func (h *handlerProductImpl) handlerSchedulePriceChange(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	dtoSchedule := &productdto.SchedulePriceInput{}
+	if err := jsonpkg.ParseBody(r, dtoSchedule); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	changeID, err := h.s.SchedulePriceChange(ctx, dtoId, dtoSchedule)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusCreated, jsonpkg.HTTPResponse{Data: changeID})
+}
+
+func (h *handlerProductImpl) handlerGetPriceHistory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	changes, err := h.s.GetPriceHistory(ctx, dtoId)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: changes})
+}
+
+func (h *handlerProductImpl) handlerBulkAdjustPrices(w http.ResponseWriter, r *http.Request) {
+	h.bulkAdjustPrices(w, r, false)
+}
+
+func (h *handlerProductImpl) handlerBulkAdjustPricesPreview(w http.ResponseWriter, r *http.Request) {
+	h.bulkAdjustPrices(w, r, true)
+}
+
+func (h *handlerProductImpl) bulkAdjustPrices(w http.ResponseWriter, r *http.Request, preview bool) {
+	ctx := r.Context()
+
+	dtoBulk := &productdto.BulkPriceInput{}
+	if err := jsonpkg.ParseBody(r, dtoBulk); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	batch, err := h.s.BulkAdjustPrices(ctx, dtoBulk, preview)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: batch})
+}
+
+func (h *handlerProductImpl) handlerBulkSetAvailability(w http.ResponseWriter, r *http.Request) {
+	h.bulkSetAvailability(w, r, false)
+}
+
+func (h *handlerProductImpl) handlerBulkSetAvailabilityPreview(w http.ResponseWriter, r *http.Request) {
+	h.bulkSetAvailability(w, r, true)
+}
+
+func (h *handlerProductImpl) bulkSetAvailability(w http.ResponseWriter, r *http.Request, preview bool) {
+	ctx := r.Context()
+
+	dtoBulk := &productdto.BulkAvailabilityInput{}
+	if err := jsonpkg.ParseBody(r, dtoBulk); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	batch, err := h.s.BulkSetAvailability(ctx, dtoBulk, preview)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: batch})
+}
+
+func (h *handlerProductImpl) handlerGetAllProductBatches(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	batches, err := h.s.GetAllProductBatches(ctx)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: batches})
+}
@@ -0,0 +1,135 @@
+package handlerimpl
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	selforderdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/self_order"
+	selforderusecases "github.com/willjrcom/sales-backend-go/internal/usecases/self_order"
+	jsonpkg "github.com/willjrcom/sales-backend-go/pkg/json"
+	"github.com/willjrcom/sales-backend-go/pkg/ratelimit"
+)
+
+type handlerSelfOrderImpl struct {
+	s       *selforderusecases.Service
+	limiter *ratelimit.Limiter
+}
+
+func NewHandlerSelfOrder(selfOrderService *selforderusecases.Service) *handler.Handler {
+	c := chi.NewRouter()
+
+	h := &handlerSelfOrderImpl{
+		s:       selfOrderService,
+		limiter: ratelimit.NewLimiter(60, time.Minute),
+	}
+
+	route := "/self-order"
+
+	c.With(h.middlewareRateLimit).Group(func(c chi.Router) {
+		c.Get("/{token}/menu", h.handlerGetMenu)
+		c.Post("/{token}/open", h.handlerOpenTableOrder)
+		c.Get("/{token}/order", h.handlerGetOrder)
+		c.Post("/{token}/item", h.handlerAddItem)
+		c.Post("/{token}/submit", h.handlerSubmitOrder)
+	})
+
+	unprotectedRoutes := []string{
+		fmt.Sprintf("%s/", route),
+	}
+
+	return handler.NewHandler(route, c, unprotectedRoutes...)
+}
+
+// middlewareRateLimit limits the requests of each table token.
+func (h *handlerSelfOrderImpl) middlewareRateLimit(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := chi.URLParam(r, "token")
+
+		if !h.limiter.Allow(token) {
+			jsonpkg.ResponseJson(w, r, http.StatusTooManyRequests, jsonpkg.Error{Message: "too many requests"})
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (h *handlerSelfOrderImpl) handlerGetMenu(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	token := chi.URLParam(r, "token")
+
+	menu, err := h.s.GetMenu(ctx, token)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusUnauthorized, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: menu})
+}
+
+func (h *handlerSelfOrderImpl) handlerOpenTableOrder(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	token := chi.URLParam(r, "token")
+
+	dtoOpen := &selforderdto.OpenSelfOrderInput{}
+	if err := jsonpkg.ParseBody(r, dtoOpen); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	output, err := h.s.OpenTableOrder(ctx, token, dtoOpen)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: output})
+}
+
+func (h *handlerSelfOrderImpl) handlerGetOrder(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	token := chi.URLParam(r, "token")
+
+	order, err := h.s.GetOrder(ctx, token)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: order})
+}
+
+func (h *handlerSelfOrderImpl) handlerAddItem(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	token := chi.URLParam(r, "token")
+
+	dtoItem := &selforderdto.AddSelfOrderItemInput{}
+	if err := jsonpkg.ParseBody(r, dtoItem); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	ids, err := h.s.AddItem(ctx, token, dtoItem)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusCreated, jsonpkg.HTTPResponse{Data: ids})
+}
+
+func (h *handlerSelfOrderImpl) handlerSubmitOrder(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	token := chi.URLParam(r, "token")
+
+	output, err := h.s.SubmitOrder(ctx, token)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: output})
+}
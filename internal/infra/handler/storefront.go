@@ -0,0 +1,259 @@
+package handlerimpl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	schemaentity "github.com/willjrcom/sales-backend-go/internal/domain/schema"
+	storefrontentity "github.com/willjrcom/sales-backend-go/internal/domain/storefront"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	storefrontdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/storefront"
+	headerservice "github.com/willjrcom/sales-backend-go/internal/infra/service/header"
+	jwtservice "github.com/willjrcom/sales-backend-go/internal/infra/service/jwt"
+	storefrontusecases "github.com/willjrcom/sales-backend-go/internal/usecases/storefront"
+	jsonpkg "github.com/willjrcom/sales-backend-go/pkg/json"
+)
+
+type handlerStorefrontImpl struct {
+	s *storefrontusecases.Service
+}
+
+func NewHandlerStorefront(storefrontService *storefrontusecases.Service) *handler.Handler {
+	c := chi.NewRouter()
+
+	h := &handlerStorefrontImpl{
+		s: storefrontService,
+	}
+
+	route := "/storefront"
+
+	c.With().Group(func(c chi.Router) {
+		c.Post("/{slug}/otp/request", h.handlerRequestOtp)
+		c.Post("/{slug}/otp/verify", h.handlerVerifyOtp)
+	})
+
+	c.With(h.middlewareCustomer).Group(func(c chi.Router) {
+		c.Post("/client", h.handlerRegisterCustomer)
+		c.Get("/client", h.handlerGetCustomer)
+		c.Put("/client/address", h.handlerUpdateAddress)
+		c.Get("/cart", h.handlerGetCart)
+		c.Post("/cart/item", h.handlerAddCartItem)
+		c.Delete("/cart/item/{id}", h.handlerRemoveCartItem)
+		c.Delete("/cart", h.handlerClearCart)
+		c.Get("/delivery-fee", h.handlerGetDeliveryFee)
+		c.Post("/checkout", h.handlerCheckout)
+	})
+
+	unprotectedRoutes := []string{
+		fmt.Sprintf("%s/", route),
+	}
+
+	return handler.NewHandler(route, c, unprotectedRoutes...)
+}
+
+// middlewareCustomer validates the customer token and sets the schema of the store and the customer on the context.
+func (h *handlerStorefrontImpl) middlewareCustomer(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, err := headerservice.GetCustomerTokenHeader(r)
+		if err != nil {
+			jsonpkg.ResponseJson(w, r, http.StatusUnauthorized, jsonpkg.Error{Message: err.Error()})
+			return
+		}
+
+		schema, ddd, number, err := jwtservice.ValidateCustomerToken(r.Context(), token)
+		if err != nil {
+			jsonpkg.ResponseJson(w, r, http.StatusUnauthorized, jsonpkg.Error{Message: err.Error()})
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), schemaentity.Schema("schema"), schema)
+		ctx = context.WithValue(ctx, storefrontentity.CustomerValue("customer"), storefrontentity.Customer{Ddd: ddd, Number: number})
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func (h *handlerStorefrontImpl) handlerRequestOtp(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	slug := chi.URLParam(r, "slug")
+
+	dtoOtp := &storefrontdto.RequestOtpInput{}
+	if err := jsonpkg.ParseBody(r, dtoOtp); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	if err := h.s.RequestOtp(ctx, slug, dtoOtp); err != nil {
+		if err == storefrontusecases.ErrTooManyOtpRequests {
+			jsonpkg.ResponseJson(w, r, http.StatusTooManyRequests, jsonpkg.Error{Message: err.Error()})
+			return
+		}
+
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+}
+
+func (h *handlerStorefrontImpl) handlerVerifyOtp(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	slug := chi.URLParam(r, "slug")
+
+	dtoOtp := &storefrontdto.VerifyOtpInput{}
+	if err := jsonpkg.ParseBody(r, dtoOtp); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	output, err := h.s.VerifyOtp(ctx, slug, dtoOtp)
+	if err == storefrontusecases.ErrTooManyOtpRequests {
+		jsonpkg.ResponseJson(w, r, http.StatusTooManyRequests, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusUnauthorized, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: output})
+}
+
+func (h *handlerStorefrontImpl) handlerRegisterCustomer(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	dtoCustomer := &storefrontdto.RegisterCustomerInput{}
+	if err := jsonpkg.ParseBody(r, dtoCustomer); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	client, err := h.s.RegisterCustomer(ctx, dtoCustomer)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusCreated, jsonpkg.HTTPResponse{Data: client})
+}
+
+func (h *handlerStorefrontImpl) handlerGetCustomer(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	client, err := h.s.GetCustomer(ctx)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusNotFound, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: client})
+}
+
+func (h *handlerStorefrontImpl) handlerUpdateAddress(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	dtoAddress := &storefrontdto.UpdateCustomerAddressInput{}
+	if err := jsonpkg.ParseBody(r, dtoAddress); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	client, err := h.s.UpdateAddress(ctx, dtoAddress)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: client})
+}
+
+func (h *handlerStorefrontImpl) handlerGetCart(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	cart, err := h.s.GetCart(ctx)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: cart})
+}
+
+func (h *handlerStorefrontImpl) handlerAddCartItem(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	dtoItem := &storefrontdto.AddCartItemInput{}
+	if err := jsonpkg.ParseBody(r, dtoItem); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	cart, err := h.s.AddCartItem(ctx, dtoItem)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusCreated, jsonpkg.HTTPResponse{Data: cart})
+}
+
+func (h *handlerStorefrontImpl) handlerRemoveCartItem(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := chi.URLParam(r, "id")
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	cart, err := h.s.RemoveCartItem(ctx, dtoId)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: cart})
+}
+
+func (h *handlerStorefrontImpl) handlerClearCart(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if err := h.s.ClearCart(ctx); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+}
+
+func (h *handlerStorefrontImpl) handlerGetDeliveryFee(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	fee, err := h.s.GetDeliveryFee(ctx)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusUnprocessableEntity, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: fee})
+}
+
+func (h *handlerStorefrontImpl) handlerCheckout(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	dtoCheckout := &storefrontdto.CheckoutInput{}
+	if err := jsonpkg.ParseBody(r, dtoCheckout); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	output, err := h.s.Checkout(ctx, dtoCheckout)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusCreated, jsonpkg.HTTPResponse{Data: output})
+}
@@ -27,6 +27,7 @@ func NewHandlerTableOrder(orderService *tableorderusecases.Service) *handler.Han
 		c.Post("/new", h.handlerRegisterTableOrder)
 		c.Post("/update/change-table/{id}", h.handlerChangeTable)
 		c.Post("/update/finish/{id}", h.handlerFinishTableOrder)
+		c.Post("/update/approve/{id}", h.handlerApproveSelfOrder)
 		c.Delete("/{id}", h.handlerDeleteTableOrderById)
 		c.Get("/{id}", h.handlerGetTableOrderById)
 		c.Get("/all", h.handlerGetAllTables)
@@ -150,3 +151,23 @@ func (h *handlerTableOrderImpl) handlerGetAllTables(w http.ResponseWriter, r *ht
 
 	jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: orders})
 }
+
+func (h *handlerTableOrderImpl) handlerApproveSelfOrder(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	if err := h.s.ApproveSelfOrder(ctx, dtoId); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+}
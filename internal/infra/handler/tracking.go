@@ -0,0 +1,131 @@
+package handlerimpl
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	trackingusecases "github.com/willjrcom/sales-backend-go/internal/usecases/tracking"
+	jsonpkg "github.com/willjrcom/sales-backend-go/pkg/json"
+	"github.com/willjrcom/sales-backend-go/pkg/ratelimit"
+)
+
+// trackingPollInterval is the interval to check the order for changes while streaming events.
+var trackingPollInterval = 5 * time.Second
+
+type handlerTrackingImpl struct {
+	s       *trackingusecases.Service
+	limiter *ratelimit.Limiter
+}
+
+func NewHandlerTracking(trackingService *trackingusecases.Service) *handler.Handler {
+	c := chi.NewRouter()
+
+	h := &handlerTrackingImpl{
+		s:       trackingService,
+		limiter: ratelimit.NewLimiter(60, time.Minute),
+	}
+
+	route := "/tracking"
+
+	c.With(h.middlewareRateLimit).Group(func(c chi.Router) {
+		c.Get("/{token}", h.handlerGetTracking)
+		c.Get("/{token}/events", h.handlerStreamTracking)
+	})
+
+	unprotectedRoutes := []string{
+		fmt.Sprintf("%s/", route),
+	}
+
+	return handler.NewHandler(route, c, unprotectedRoutes...)
+}
+
+// middlewareRateLimit limits the requests of each tracking token.
+func (h *handlerTrackingImpl) middlewareRateLimit(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := chi.URLParam(r, "token")
+
+		if !h.limiter.Allow(token) {
+			jsonpkg.ResponseJson(w, r, http.StatusTooManyRequests, jsonpkg.Error{Message: "too many requests"})
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (h *handlerTrackingImpl) handlerGetTracking(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	token := chi.URLParam(r, "token")
+
+	tracking, err := h.s.GetTracking(ctx, token)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusNotFound, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: tracking})
+}
+
+// handlerStreamTracking sends the tracking as server-sent events on every change, until the order is done.
+func (h *handlerTrackingImpl) handlerStreamTracking(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	token := chi.URLParam(r, "token")
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: "streaming not supported"})
+		return
+	}
+
+	tracking, err := h.s.GetTracking(ctx, token)
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusNotFound, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+
+	ticker := time.NewTicker(trackingPollInterval)
+	defer ticker.Stop()
+
+	lastVersion := ""
+
+	for {
+		version, err := tracking.Version()
+		if err != nil {
+			return
+		}
+
+		if version != lastVersion {
+			content, err := json.Marshal(tracking)
+			if err != nil {
+				return
+			}
+
+			fmt.Fprintf(w, "id: %s\nevent: tracking\ndata: %s\n\n", version, content)
+			flusher.Flush()
+			lastVersion = version
+		}
+
+		if tracking.Done {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if tracking, err = h.s.GetTracking(ctx, token); err != nil {
+			return
+		}
+	}
+}
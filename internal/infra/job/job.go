@@ -0,0 +1,43 @@
+package job
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	schemaentity "github.com/willjrcom/sales-backend-go/internal/domain/schema"
+)
+
+// RunForAllSchemas runs the task on every company schema at each interval until the context is done.
+func RunForAllSchemas(ctx context.Context, db *bun.DB, interval time.Duration, name string, task func(ctx context.Context) error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			runOnSchemas(ctx, db, name, task)
+		}
+	}
+}
+
+func runOnSchemas(ctx context.Context, db *bun.DB, name string, task func(ctx context.Context) error) {
+	schemas, err := database.GetAllSchemas(ctx, db)
+
+	if err != nil {
+		log.Printf("job %s error: %v", name, err)
+		return
+	}
+
+	for _, schema := range schemas {
+		ctxSchema := context.WithValue(ctx, schemaentity.Schema("schema"), schema)
+
+		if err := task(ctxSchema); err != nil {
+			log.Printf("job %s error on %s: %v", name, schema, err)
+		}
+	}
+}
@@ -75,3 +75,7 @@ func (r *CategoryRepositoryLocal) GetAllCategories(_ context.Context) ([]product
 
 	return Categoryproducts, nil
 }
+
+func (r *CategoryRepositoryLocal) CloneCategory(ctx context.Context, p *productentity.Category) error {
+	return r.RegisterCategory(ctx, p)
+}
@@ -29,6 +29,10 @@ func (r *EmployeeRepositoryLocal) GetEmployeeById(ctx context.Context, id string
 	return nil, nil
 }
 
+func (r *EmployeeRepositoryLocal) GetEmployeeByUserId(ctx context.Context, userID string) (*employeeentity.Employee, error) {
+	return nil, nil
+}
+
 func (r *EmployeeRepositoryLocal) GetAllEmployees(ctx context.Context) ([]employeeentity.Employee, error) {
 	return nil, nil
 }
@@ -63,6 +63,18 @@ func (r *OrderRepositoryLocal) GetAllOrders(ctx context.Context) ([]orderentity.
 	return orders, nil
 }
 
+func (r *OrderRepositoryLocal) GetOrdersByClientId(ctx context.Context, clientID string) ([]orderentity.Order, error) {
+	orders := make([]orderentity.Order, 0)
+
+	for _, p := range r.orders {
+		if p.Delivery != nil && p.Delivery.ClientID.String() == clientID {
+			orders = append(orders, *p)
+		}
+	}
+
+	return orders, nil
+}
+
 func (r *OrderRepositoryLocal) UpdateDeliveryOrder(ctx context.Context, delivery *orderentity.DeliveryOrder) error {
 	return nil
 }
@@ -82,3 +94,7 @@ func (r *OrderRepositoryLocal) DeleteTableOrder(ctx context.Context, id string)
 func (r *OrderRepositoryLocal) AddPaymentOrder(ctx context.Context, payment *orderentity.PaymentOrder) error {
 	return nil
 }
+
+func (r *OrderRepositoryLocal) UpdatePaymentOrders(ctx context.Context, payments []orderentity.PaymentOrder) error {
+	return nil
+}
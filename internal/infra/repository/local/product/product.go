@@ -39,6 +39,11 @@ func (r *ProductRepositoryLocal) UpdateProduct(_ context.Context, p *productenti
 	return nil
 }
 
+func (r *ProductRepositoryLocal) UpdateProductWithPriceChange(_ context.Context, p *productentity.Product, _ *productentity.ProductPriceChange) error {
+	r.products[p.ID] = p
+	return nil
+}
+
 func (r *ProductRepositoryLocal) DeleteProduct(_ context.Context, id string) error {
 
 	if _, ok := r.products[uuid.MustParse(id)]; !ok {
@@ -80,3 +85,32 @@ func (r *ProductRepositoryLocal) GetAllProducts(_ context.Context) ([]productent
 
 	return products, nil
 }
+
+func (r *ProductRepositoryLocal) MergeProducts(_ context.Context, p *productentity.Product, mergedIDs []string) error {
+	for _, id := range mergedIDs {
+		delete(r.products, uuid.MustParse(id))
+	}
+
+	r.products[p.ID] = p
+	return nil
+}
+
+func (r *ProductRepositoryLocal) ApplyProductBatch(_ context.Context, _ *productentity.ProductBatch, products []productentity.Product, _ []productentity.ProductPriceChange) error {
+	for i := range products {
+		r.products[products[i].ID] = &products[i]
+	}
+
+	return nil
+}
+
+func (r *ProductRepositoryLocal) ImportCatalog(_ context.Context, catalog *productentity.CatalogImport) error {
+	for _, product := range catalog.Products() {
+		r.products[product.ID] = product
+	}
+
+	return nil
+}
+
+func (r *ProductRepositoryLocal) GetAllProductBatches(_ context.Context) ([]productentity.ProductBatch, error) {
+	return []productentity.ProductBatch{}, nil
+}
@@ -79,6 +79,27 @@ func (r *AddressRepositoryBun) GetAddressById(ctx context.Context, id string) (*
 	return aAddress, nil
 }
 
+// GetDeliveryTaxByNeighborhood returns the last delivery tax charged on the neighborhood.
+func (r *AddressRepositoryBun) GetDeliveryTaxByNeighborhood(ctx context.Context, neighborhood string, city string) (float64, error) {
+	aAddress := &addressentity.Address{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return 0, err
+	}
+
+	if err := r.db.NewSelect().Model(aAddress).
+		Where("lower(address.neighborhood) = lower(?) AND lower(address.city) = lower(?)", neighborhood, city).
+		Where("address.delivery_tax > 0").
+		Order("address.updated_at DESC").Limit(1).Scan(ctx); err != nil {
+		return 0, err
+	}
+
+	return aAddress.DeliveryTax, nil
+}
+
 func (r *AddressRepositoryBun) GetAllAddress(ctx context.Context) ([]addressentity.Address, error) {
 	addresss := []addressentity.Address{}
 
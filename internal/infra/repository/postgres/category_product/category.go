@@ -165,7 +165,7 @@ func (r *CategoryProductRepositoryBun) GetCategoryById(ctx context.Context, id s
 		return nil, err
 	}
 
-	if err := r.db.NewSelect().Model(category).Where("id = ?", id).Relation("Products").Relation("Sizes").Relation("Quantities").Relation("ProcessRules").Relation("AdditionalCategories").Scan(ctx); err != nil {
+	if err := r.db.NewSelect().Model(category).Where("id = ?", id).Relation("Products.Variants").Relation("Products.ComboSlots").Relation("Sizes").Relation("Quantities").Relation("ProcessRules").Relation("AdditionalCategories").Scan(ctx); err != nil {
 		return nil, err
 	}
 
@@ -211,3 +211,77 @@ func (r *CategoryProductRepositoryBun) GetAllCategories(ctx context.Context) ([]
 
 	return categories, nil
 }
+
+// CloneCategory inserts the category with its sizes, quantities, process rules and products in one transaction.
+func (r *CategoryProductRepositoryBun) CloneCategory(ctx context.Context, c *productentity.Category) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
+
+	if err != nil {
+		return err
+	}
+
+	if err := r.insertClone(ctx, tx, c); err != nil {
+		if errRollBack := tx.Rollback(); errRollBack != nil {
+			return errRollBack
+		}
+
+		return err
+	}
+
+	return r.updateAdditionalCategories(ctx, tx, c.ID, c.AdditionalCategories)
+}
+
+func (r *CategoryProductRepositoryBun) insertClone(ctx context.Context, tx bun.Tx, c *productentity.Category) error {
+	if _, err := tx.NewInsert().Model(c).Exec(ctx); err != nil {
+		return err
+	}
+
+	if len(c.Sizes) != 0 {
+		if _, err := tx.NewInsert().Model(&c.Sizes).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	if len(c.Quantities) != 0 {
+		if _, err := tx.NewInsert().Model(&c.Quantities).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	if len(c.ProcessRules) != 0 {
+		if _, err := tx.NewInsert().Model(&c.ProcessRules).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	if len(c.Products) == 0 {
+		return nil
+	}
+
+	if _, err := tx.NewInsert().Model(&c.Products).Exec(ctx); err != nil {
+		return err
+	}
+
+	for _, product := range c.Products {
+		if len(product.Variants) != 0 {
+			if _, err := tx.NewInsert().Model(&product.Variants).Exec(ctx); err != nil {
+				return err
+			}
+		}
+
+		if len(product.ComboSlots) != 0 {
+			if _, err := tx.NewInsert().Model(&product.ComboSlots).Exec(ctx); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -75,24 +75,32 @@ func (r *ClientRepositoryBun) UpdateClient(ctx context.Context, c *cliententity.
 		return err
 	}
 
+	// Register or update the default contact, other contacts are kept
 	if c.Contact != nil {
-		if _, err := tx.NewDelete().Model(&personentity.Contact{}).Where("object_id = ?", c.ID).Exec(ctx); err != nil {
-			return rollback(&tx, err)
-		}
-
-		// Register contact
-		if _, err := tx.NewInsert().Model(c.Contact).Exec(ctx); err != nil {
+		if _, err := tx.NewInsert().Model(c.Contact).On("CONFLICT (id) DO UPDATE").Set("ddd = EXCLUDED.ddd").Set("number = EXCLUDED.number").Set("type = EXCLUDED.type").Exec(ctx); err != nil {
 			return rollback(&tx, err)
 		}
 	}
 
+	// Register or update the default address, other addresses are kept
 	if c.Address != nil {
-		if _, err := tx.NewDelete().Model(&addressentity.Address{}).Where("object_id = ?", c.ID).Exec(ctx); err != nil {
+		if _, err := tx.NewUpdate().Model(&addressentity.Address{}).Set("is_default = false").Where("object_id = ?", c.ID).Where("id <> ?", c.Address.ID).Exec(ctx); err != nil {
 			return rollback(&tx, err)
 		}
 
-		// Register addresse
-		if _, err := tx.NewInsert().Model(c.Address).Exec(ctx); err != nil {
+		if _, err := tx.NewInsert().Model(c.Address).On("CONFLICT (id) DO UPDATE").
+			Set("street = EXCLUDED.street").
+			Set("number = EXCLUDED.number").
+			Set("complement = EXCLUDED.complement").
+			Set("reference = EXCLUDED.reference").
+			Set("neighborhood = EXCLUDED.neighborhood").
+			Set("city = EXCLUDED.city").
+			Set("state = EXCLUDED.state").
+			Set("cep = EXCLUDED.cep").
+			Set("delivery_tax = EXCLUDED.delivery_tax").
+			Set("label = EXCLUDED.label").
+			Set("is_default = EXCLUDED.is_default").
+			Exec(ctx); err != nil {
 			return rollback(&tx, err)
 		}
 	}
@@ -150,10 +158,11 @@ func (r *ClientRepositoryBun) GetClientById(ctx context.Context, id string) (*cl
 		return nil, err
 	}
 
-	if err := r.db.NewSelect().Model(client).Where("client.id = ?", id).Relation("Address").Relation("Contact").Scan(ctx); err != nil {
+	if err := r.db.NewSelect().Model(client).Where("client.id = ?", id).Relation("Addresses", orderAddresses).Relation("Contacts", orderContacts).Scan(ctx); err != nil {
 		return nil, err
 	}
 
+	client.LoadDefaults()
 	return client, nil
 }
 
@@ -166,13 +175,156 @@ func (r *ClientRepositoryBun) GetAllClients(ctx context.Context) ([]cliententity
 		return nil, err
 	}
 
-	if err := r.db.NewSelect().Model(&clients).Relation("Address").Relation("Contact").Scan(ctx); err != nil {
+	if err := r.db.NewSelect().Model(&clients).Relation("Addresses", orderAddresses).Relation("Contacts", orderContacts).Scan(ctx); err != nil {
 		return nil, err
 	}
 
+	for i := range clients {
+		clients[i].LoadDefaults()
+	}
+
 	return clients, nil
 }
 
+// SetDefaultAddress marks the address as the only default address of the client.
+func (r *ClientRepositoryBun) SetDefaultAddress(ctx context.Context, clientID string, addressID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	if _, err := r.db.NewUpdate().Model(&addressentity.Address{}).Set("is_default = (id = ?)", addressID).Where("object_id = ?", clientID).Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetAllClientStats sums the completed orders of each client, delivered to the client or with loyalty points of the client.
+func (r *ClientRepositoryBun) GetAllClientStats(ctx context.Context) ([]cliententity.ClientStats, error) {
+	stats := []cliententity.ClientStats{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	clientOrders := r.db.NewSelect().Table("delivery_orders").Column("client_id", "order_id").
+		UnionAll(r.db.NewSelect().Table("loyalty_transactions").Column("client_id", "order_id").Where("order_id IS NOT NULL"))
+
+	query := r.db.NewSelect().
+		With("client_orders", clientOrders).
+		TableExpr("(SELECT DISTINCT client_id, order_id FROM client_orders) AS co").
+		Join("JOIN orders AS o ON o.id = co.order_id").
+		ColumnExpr("co.client_id").
+		ColumnExpr("COUNT(o.id) AS order_count").
+		ColumnExpr("COALESCE(SUM(o.total_payable), 0) AS total_spent").
+		ColumnExpr("MIN(COALESCE(o.pending_at, o.created_at)) AS first_order_at").
+		ColumnExpr("MAX(COALESCE(o.pending_at, o.created_at)) AS last_order_at").
+		Where("o.finished_at IS NOT NULL").
+		Where("o.canceled_at IS NULL").
+		Group("co.client_id")
+
+	if err := query.Scan(ctx, &stats); err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// MergeClients moves the delivery orders, addresses, contacts, loyalty transactions and cart of the merged clients
+// to the surviving client, deletes the merged clients and keeps the audit records, all in one transaction.
+func (r *ClientRepositoryBun) MergeClients(ctx context.Context, survivor *cliententity.Client, merges []cliententity.ClientMerge) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	tx, err := r.db.Begin()
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.NewUpdate().Model(survivor).Column("cpf", "email", "birthday").Where("id = ?", survivor.ID).Exec(ctx); err != nil {
+		return rollback(&tx, err)
+	}
+
+	for _, merge := range merges {
+		if _, err := tx.NewUpdate().Table("delivery_orders").Set("client_id = ?", merge.SurvivorID).Where("client_id = ?", merge.MergedID).Exec(ctx); err != nil {
+			return rollback(&tx, err)
+		}
+
+		// The default address of the surviving client is kept
+		if _, err := tx.NewUpdate().Table("addresses").Set("object_id = ?", merge.SurvivorID).Set("is_default = false").Where("object_id = ?", merge.MergedID).Exec(ctx); err != nil {
+			return rollback(&tx, err)
+		}
+
+		if _, err := tx.NewUpdate().Table("contacts").Set("object_id = ?", merge.SurvivorID).Where("object_id = ?", merge.MergedID).Exec(ctx); err != nil {
+			return rollback(&tx, err)
+		}
+
+		if _, err := tx.NewUpdate().Table("loyalty_transactions").Set("client_id = ?", merge.SurvivorID).Where("client_id = ?", merge.MergedID).Exec(ctx); err != nil {
+			return rollback(&tx, err)
+		}
+
+		// A client has one cart, the cart of the merged client is kept only when the surviving client has none
+		if _, err := tx.NewUpdate().Table("carts").Set("client_id = ?", merge.SurvivorID).Where("client_id = ?", merge.MergedID).
+			Where("NOT EXISTS (SELECT 1 FROM carts WHERE client_id = ?)", merge.SurvivorID).Exec(ctx); err != nil {
+			return rollback(&tx, err)
+		}
+
+		if _, err := tx.NewDelete().Table("carts").Where("client_id = ?", merge.MergedID).Exec(ctx); err != nil {
+			return rollback(&tx, err)
+		}
+
+		if _, err := tx.NewDelete().Model(&cliententity.Client{}).Where("id = ?", merge.MergedID).Exec(ctx); err != nil {
+			return rollback(&tx, err)
+		}
+	}
+
+	if _, err := tx.NewInsert().Model(&merges).Exec(ctx); err != nil {
+		return rollback(&tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *ClientRepositoryBun) GetAllClientMerges(ctx context.Context) ([]cliententity.ClientMerge, error) {
+	merges := []cliententity.ClientMerge{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(&merges).Order("created_at DESC").Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return merges, nil
+}
+
+func orderAddresses(q *bun.SelectQuery) *bun.SelectQuery {
+	return q.Order("is_default DESC", "created_at")
+}
+
+func orderContacts(q *bun.SelectQuery) *bun.SelectQuery {
+	return q.Order("created_at")
+}
+
 func rollback(tx *bun.Tx, err error) error {
 	if errRoolback := tx.Rollback(); errRoolback != nil {
 		return errRoolback
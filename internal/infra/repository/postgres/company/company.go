@@ -83,6 +83,27 @@ func (r *CompanyRepositoryBun) GetCompany(ctx context.Context) (*companyentity.C
 	return company, err
 }
 
+// GetCompanyBySlug searches the public schema, it is used on routes without a logged user.
+func (r *CompanyRepositoryBun) GetCompanyBySlug(ctx context.Context, slug string) (*companyentity.Company, error) {
+	companyWithUsers := &companyentity.CompanyWithUsers{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeToPublicSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(companyWithUsers).Where("slug = ?", slug).Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return &companyentity.Company{
+		Entity:                  companyWithUsers.Entity,
+		CompanyCommonAttributes: companyWithUsers.CompanyCommonAttributes,
+	}, nil
+}
+
 func (r *CompanyRepositoryBun) ValidateUserToPublicCompany(ctx context.Context, userID uuid.UUID) (bool, error) {
 	schema := ctx.Value(schemaentity.Schema("schema")).(string)
 
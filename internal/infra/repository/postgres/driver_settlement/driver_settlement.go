@@ -0,0 +1,190 @@
+package driversettlementrepositorybun
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	driversettlemententity "github.com/willjrcom/sales-backend-go/internal/domain/driver_settlement"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+)
+
+type DriverSettlementRepositoryBun struct {
+	mu sync.Mutex
+	db *bun.DB
+}
+
+func NewDriverSettlementRepositoryBun(db *bun.DB) *DriverSettlementRepositoryBun {
+	return &DriverSettlementRepositoryBun{db: db}
+}
+
+func (r *DriverSettlementRepositoryBun) RegisterDriverSettlement(ctx context.Context, settlement *driversettlemententity.DriverSettlement) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	if _, err := r.db.NewInsert().Model(settlement).Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *DriverSettlementRepositoryBun) UpdateDriverSettlement(ctx context.Context, settlement *driversettlemententity.DriverSettlement) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	if _, err := r.db.NewUpdate().Model(settlement).Where("id = ?", settlement.ID).Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CloseDriverSettlement saves the settled report and links its deliveries, so they are not paid twice.
+func (r *DriverSettlementRepositoryBun) CloseDriverSettlement(ctx context.Context, settlement *driversettlemententity.DriverSettlement) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.NewUpdate().Model(settlement).Where("id = ?", settlement.ID).Exec(ctx); err != nil {
+		return rollback(&tx, err)
+	}
+
+	if ids := settlement.DeliveryIDs(); len(ids) != 0 {
+		res, err := tx.NewUpdate().Model(&orderentity.DeliveryOrder{}).
+			Set("settlement_id = ?", settlement.ID).
+			Where("id IN (?)", bun.In(ids)).
+			Where("settlement_id IS NULL").
+			Exec(ctx)
+
+		if err != nil {
+			return rollback(&tx, err)
+		}
+
+		// a delivery linked to another settlement meanwhile is not paid twice
+		if rows, err := res.RowsAffected(); err != nil {
+			return rollback(&tx, err)
+		} else if rows != int64(len(ids)) {
+			return rollback(&tx, driversettlemententity.ErrDeliveryAlreadySettled)
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *DriverSettlementRepositoryBun) GetDriverSettlementById(ctx context.Context, id string) (*driversettlemententity.DriverSettlement, error) {
+	settlement := &driversettlemententity.DriverSettlement{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(settlement).Where("id = ?", id).Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return settlement, nil
+}
+
+func (r *DriverSettlementRepositoryBun) GetAllDriverSettlements(ctx context.Context) ([]driversettlemententity.DriverSettlement, error) {
+	settlements := []driversettlemententity.DriverSettlement{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(&settlements).Order("created_at DESC").Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return settlements, nil
+}
+
+// GetDeliveriesToSettle returns the delivered orders of the driver in the period not settled yet.
+func (r *DriverSettlementRepositoryBun) GetDeliveriesToSettle(ctx context.Context, period *driversettlemententity.SettlementPeriod) ([]orderentity.DeliveryOrder, error) {
+	deliveries := []orderentity.DeliveryOrder{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	query := r.db.NewSelect().Model(&deliveries).
+		Where("delivery.driver_id = ?", period.DriverID).
+		Where("delivery.delivered_at IS NOT NULL").
+		Where("delivery.settlement_id IS NULL")
+
+	if period.ShiftID != nil {
+		query = query.Where("delivery.order_id IN (SELECT id FROM orders WHERE shift_id = ?)", *period.ShiftID)
+	}
+
+	if period.StartAt != nil && period.EndAt != nil {
+		query = query.Where("delivery.delivered_at BETWEEN ? AND ?", *period.StartAt, *period.EndAt)
+	}
+
+	if err := query.Order("delivery.delivered_at ASC").Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+func (r *DriverSettlementRepositoryBun) GetPaymentsCollected(ctx context.Context, driverID uuid.UUID, orderIDs []uuid.UUID) ([]orderentity.PaymentOrder, error) {
+	payments := []orderentity.PaymentOrder{}
+
+	if len(orderIDs) == 0 {
+		return payments, nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(&payments).
+		Where("payment.driver_id = ?", driverID).
+		Where("payment.order_id IN (?)", bun.In(orderIDs)).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return payments, nil
+}
+
+func rollback(tx *bun.Tx, err error) error {
+	if errRollBack := tx.Rollback(); errRollBack != nil {
+		return errRollBack
+	}
+
+	return err
+}
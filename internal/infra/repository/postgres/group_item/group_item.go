@@ -174,3 +174,53 @@ func (r *GroupItemRepositoryBun) GetGroupsByOrderIDAndStatus(ctx context.Context
 
 	return items, nil
 }
+
+// AddComboItems creates the new groups, adds the items of the combo and updates the totals of the groups in one transaction.
+func (r *GroupItemRepositoryBun) AddComboItems(ctx context.Context, created []groupitementity.GroupItem, updated []groupitementity.GroupItem, items []itementity.Item) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
+
+	if err != nil {
+		return err
+	}
+
+	rollback := func(err error) error {
+		if errRoolback := tx.Rollback(); errRoolback != nil {
+			return errRoolback
+		}
+
+		return err
+	}
+
+	for i := range created {
+		if _, err = tx.NewInsert().Model(&created[i]).Exec(ctx); err != nil {
+			return rollback(err)
+		}
+	}
+
+	for i := range items {
+		if _, err = tx.NewInsert().Model(&items[i]).Exec(ctx); err != nil {
+			return rollback(err)
+		}
+	}
+
+	for i := range updated {
+		if _, err = tx.NewUpdate().Model(&updated[i]).Where("id = ?", updated[i].ID).Exec(ctx); err != nil {
+			return rollback(err)
+		}
+
+		if updated[i].ComplementItem != nil {
+			if _, err = tx.NewUpdate().Model(updated[i].ComplementItem).Where("id = ?", updated[i].ComplementItem.ID).Exec(ctx); err != nil {
+				return rollback(err)
+			}
+		}
+	}
+
+	return tx.Commit()
+}
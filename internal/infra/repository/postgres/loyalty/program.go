@@ -0,0 +1,52 @@
+package loyaltyrepositorybun
+
+import (
+	"context"
+	"sync"
+
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	loyaltyentity "github.com/willjrcom/sales-backend-go/internal/domain/loyalty"
+)
+
+type ProgramRepositoryBun struct {
+	mu sync.Mutex
+	db *bun.DB
+}
+
+func NewProgramRepositoryBun(db *bun.DB) *ProgramRepositoryBun {
+	return &ProgramRepositoryBun{db: db}
+}
+
+// SaveProgram inserts the program of the company or replaces its configuration.
+func (r *ProgramRepositoryBun) SaveProgram(ctx context.Context, program *loyaltyentity.LoyaltyProgram) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	if _, err := r.db.NewInsert().Model(program).On("CONFLICT (id) DO UPDATE").Set("is_active = EXCLUDED.is_active").Set("points_per_currency = EXCLUDED.points_per_currency").Set("updated_at = now()").Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *ProgramRepositoryBun) GetProgram(ctx context.Context) (*loyaltyentity.LoyaltyProgram, error) {
+	program := &loyaltyentity.LoyaltyProgram{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(program).Order("created_at ASC").Limit(1).Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return program, nil
+}
@@ -0,0 +1,83 @@
+package loyaltyrepositorybun
+
+import (
+	"context"
+	"sync"
+
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	loyaltyentity "github.com/willjrcom/sales-backend-go/internal/domain/loyalty"
+)
+
+type RewardRepositoryBun struct {
+	mu sync.Mutex
+	db *bun.DB
+}
+
+func NewRewardRepositoryBun(db *bun.DB) *RewardRepositoryBun {
+	return &RewardRepositoryBun{db: db}
+}
+
+func (r *RewardRepositoryBun) RegisterReward(ctx context.Context, reward *loyaltyentity.Reward) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	if _, err := r.db.NewInsert().Model(reward).Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *RewardRepositoryBun) UpdateReward(ctx context.Context, reward *loyaltyentity.Reward) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	if _, err := r.db.NewUpdate().Model(reward).Where("id = ?", reward.ID).Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *RewardRepositoryBun) GetRewardById(ctx context.Context, id string) (*loyaltyentity.Reward, error) {
+	reward := &loyaltyentity.Reward{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(reward).Where("id = ?", id).Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return reward, nil
+}
+
+func (r *RewardRepositoryBun) GetAllRewards(ctx context.Context) ([]loyaltyentity.Reward, error) {
+	rewards := []loyaltyentity.Reward{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(&rewards).Order("points ASC").Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return rewards, nil
+}
@@ -0,0 +1,173 @@
+package loyaltyrepositorybun
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	loyaltyentity "github.com/willjrcom/sales-backend-go/internal/domain/loyalty"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+	shiftentity "github.com/willjrcom/sales-backend-go/internal/domain/shift"
+)
+
+type TransactionRepositoryBun struct {
+	mu sync.Mutex
+	db *bun.DB
+}
+
+func NewTransactionRepositoryBun(db *bun.DB) *TransactionRepositoryBun {
+	return &TransactionRepositoryBun{db: db}
+}
+
+func (r *TransactionRepositoryBun) RegisterTransaction(ctx context.Context, transaction *loyaltyentity.LoyaltyTransaction) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	if _, err := r.db.NewInsert().Model(transaction).Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ReverseTransaction marks the transaction as reversed and inserts the reversal in the same transaction.
+func (r *TransactionRepositoryBun) ReverseTransaction(ctx context.Context, transaction *loyaltyentity.LoyaltyTransaction, reversal *loyaltyentity.LoyaltyTransaction) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.NewUpdate().Model(transaction).Column("reversed_at").Where("id = ?", transaction.ID).Where("reversed_at IS NULL").Exec(ctx); err != nil {
+		if errRollBack := tx.Rollback(); errRollBack != nil {
+			return errRollBack
+		}
+
+		return err
+	}
+
+	if _, err := tx.NewInsert().Model(reversal).Exec(ctx); err != nil {
+		if errRollBack := tx.Rollback(); errRollBack != nil {
+			return errRollBack
+		}
+
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// RedeemReward locks the client to check the balance and saves the redeem, the discount of the order
+// and the redeem of the shift in one transaction, concurrent redeems of the client wait for each other.
+func (r *TransactionRepositoryBun) RedeemReward(ctx context.Context, transaction *loyaltyentity.LoyaltyTransaction, order *orderentity.Order, redeem *shiftentity.Redeem) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
+
+	if err != nil {
+		return err
+	}
+
+	if err := r.redeemReward(ctx, tx, transaction, order, redeem); err != nil {
+		if errRollBack := tx.Rollback(); errRollBack != nil {
+			return errRollBack
+		}
+
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *TransactionRepositoryBun) redeemReward(ctx context.Context, tx bun.Tx, transaction *loyaltyentity.LoyaltyTransaction, order *orderentity.Order, redeem *shiftentity.Redeem) error {
+	var clientID string
+	if err := tx.NewRaw("SELECT id FROM clients WHERE id = ? FOR UPDATE", transaction.ClientID).Scan(ctx, &clientID); err != nil {
+		return err
+	}
+
+	balance := 0
+	if err := tx.NewSelect().Model((*loyaltyentity.LoyaltyTransaction)(nil)).ColumnExpr("COALESCE(SUM(points), 0)").Where("client_id = ?", transaction.ClientID).Scan(ctx, &balance); err != nil {
+		return err
+	}
+
+	if balance+transaction.Points < 0 {
+		return loyaltyentity.ErrInsufficientPoints
+	}
+
+	if _, err := tx.NewUpdate().Model(order).Where("id = ?", order.ID).Exec(ctx); err != nil {
+		return err
+	}
+
+	if _, err := tx.NewInsert().Model(transaction).Exec(ctx); err != nil {
+		return err
+	}
+
+	if order.ShiftID == nil || redeem == nil {
+		return nil
+	}
+
+	shift := &shiftentity.Shift{}
+	if err := tx.NewSelect().Model(shift).Where("id = ?", order.ShiftID).For("UPDATE").Scan(ctx); err != nil {
+		return err
+	}
+
+	shift.AddRedeem(*redeem)
+
+	if _, err := tx.NewUpdate().Model(shift).Column("redeems").Where("id = ?", shift.ID).Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *TransactionRepositoryBun) GetTransactionsByClientID(ctx context.Context, clientID string) ([]loyaltyentity.LoyaltyTransaction, error) {
+	transactions := []loyaltyentity.LoyaltyTransaction{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(&transactions).Where("client_id = ?", clientID).Order("created_at DESC").Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return transactions, nil
+}
+
+func (r *TransactionRepositoryBun) GetTransactionsByOrderID(ctx context.Context, orderID string) ([]loyaltyentity.LoyaltyTransaction, error) {
+	transactions := []loyaltyentity.LoyaltyTransaction{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(&transactions).Where("order_id = ?", orderID).Order("created_at ASC").Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return transactions, nil
+}
@@ -2,6 +2,7 @@ package orderrepositorybun
 
 import (
 	"context"
+	"database/sql"
 	"sync"
 
 	"github.com/uptrace/bun"
@@ -90,9 +91,239 @@ func (r *DeliveryOrderRepositoryBun) GetDeliveryById(ctx context.Context, id str
 		return nil, err
 	}
 
-	if err := r.db.NewSelect().Model(delivery).Where("delivery.id = ?", id).Relation("Client.Address").Relation("Address").Relation("Driver").Scan(ctx); err != nil {
+	if err := r.db.NewSelect().Model(delivery).Where("delivery.id = ?", id).Relation("Client").Relation("Address").Relation("Driver").Scan(ctx); err != nil {
 		return nil, err
 	}
 
 	return delivery, nil
 }
+
+func (r *DeliveryOrderRepositoryBun) GetDeliveriesByClientId(ctx context.Context, clientID string) ([]orderentity.DeliveryOrder, error) {
+	deliveries := []orderentity.DeliveryOrder{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(&deliveries).Where("delivery.client_id = ?", clientID).Relation("Address").Relation("Driver").Order("delivery.created_at DESC").Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+func (r *DeliveryOrderRepositoryBun) GetDeliveriesByStatus(ctx context.Context, status orderentity.StatusDeliveryOrder) ([]orderentity.DeliveryOrder, error) {
+	deliveries := []orderentity.DeliveryOrder{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(&deliveries).Where("delivery.status = ?", status).Relation("Client").Relation("Address").Relation("Driver").Order("delivery.created_at DESC").Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+// GetDeliveriesByDriverId returns the run of the driver, the deliveries not delivered yet in the order of the stops.
+func (r *DeliveryOrderRepositoryBun) GetDeliveriesByDriverId(ctx context.Context, driverID string) ([]orderentity.DeliveryOrder, error) {
+	deliveries := []orderentity.DeliveryOrder{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(&deliveries).
+		Where("delivery.driver_id = ?", driverID).
+		Where("delivery.delivered_at IS NULL").
+		Relation("Client").Relation("Address").
+		Order("delivery.launched_at ASC", "delivery.stop ASC").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+// GetDeliveriesToDispatch returns the deliveries of placed orders waiting for a driver, oldest first.
+func (r *DeliveryOrderRepositoryBun) GetDeliveriesToDispatch(ctx context.Context) ([]orderentity.DeliveryOrder, error) {
+	deliveries := []orderentity.DeliveryOrder{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(&deliveries).
+		Where("delivery.status IN (?)", bun.In([]orderentity.StatusDeliveryOrder{orderentity.DeliveryOrderStatusPending, orderentity.DeliveryOrderStatusReady, orderentity.DeliveryOrderStatusReturned})).
+		Where("delivery.order_id IN (SELECT id FROM orders WHERE status = ?)", orderentity.OrderStatusPending).
+		Relation("Client").Relation("Address").
+		Order("delivery.created_at ASC").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+// UpdateDeliveryOrders updates the deliveries in one transaction, used to launch a batch.
+func (r *DeliveryOrderRepositoryBun) UpdateDeliveryOrders(ctx context.Context, deliveries []orderentity.DeliveryOrder) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
+
+	if err != nil {
+		return err
+	}
+
+	for i := range deliveries {
+		if _, err := tx.NewUpdate().Model(&deliveries[i]).Where("id = ?", deliveries[i].ID).Exec(ctx); err != nil {
+			if errRollBack := tx.Rollback(); errRollBack != nil {
+				return errRollBack
+			}
+
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// DeliverDeliveryOrder updates the delivered delivery with the payment collected by the driver in one transaction,
+// the order and payment are nil when nothing was collected.
+func (r *DeliveryOrderRepositoryBun) DeliverDeliveryOrder(ctx context.Context, delivery *orderentity.DeliveryOrder, order *orderentity.Order, payment *orderentity.PaymentOrder) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
+
+	if err != nil {
+		return err
+	}
+
+	if err := r.deliver(ctx, tx, delivery, order, payment); err != nil {
+		if errRollBack := tx.Rollback(); errRollBack != nil {
+			return errRollBack
+		}
+
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *DeliveryOrderRepositoryBun) deliver(ctx context.Context, tx bun.Tx, delivery *orderentity.DeliveryOrder, order *orderentity.Order, payment *orderentity.PaymentOrder) error {
+	if _, err := tx.NewUpdate().Model(delivery).Where("id = ?", delivery.ID).Exec(ctx); err != nil {
+		return err
+	}
+
+	if payment == nil {
+		return nil
+	}
+
+	if _, err := tx.NewInsert().Model(payment).Exec(ctx); err != nil {
+		return err
+	}
+
+	if _, err := tx.NewUpdate().Model(order).Where("id = ?", order.ID).Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateDeliveryWithRefunds updates the failed or canceled delivery with the refunded payments and the order in one transaction,
+// the groups and items of a canceled order are canceled with it.
+func (r *DeliveryOrderRepositoryBun) UpdateDeliveryWithRefunds(ctx context.Context, delivery *orderentity.DeliveryOrder, order *orderentity.Order, refunds []orderentity.PaymentOrder) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
+
+	if err != nil {
+		return err
+	}
+
+	if err := r.updateWithRefunds(ctx, tx, delivery, order, refunds); err != nil {
+		if errRollBack := tx.Rollback(); errRollBack != nil {
+			return errRollBack
+		}
+
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *DeliveryOrderRepositoryBun) updateWithRefunds(ctx context.Context, tx bun.Tx, delivery *orderentity.DeliveryOrder, order *orderentity.Order, refunds []orderentity.PaymentOrder) error {
+	if _, err := tx.NewUpdate().Model(delivery).Where("id = ?", delivery.ID).Exec(ctx); err != nil {
+		return err
+	}
+
+	for i := range refunds {
+		if _, err := tx.NewUpdate().Model(&refunds[i]).Where("id = ?", refunds[i].ID).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	if _, err := tx.NewUpdate().Model(order).Where("id = ?", order.ID).Exec(ctx); err != nil {
+		return err
+	}
+
+	if order.Status != orderentity.OrderStatusCanceled {
+		return nil
+	}
+
+	for i := range order.Groups {
+		group := &order.Groups[i]
+		if _, err := tx.NewUpdate().Model(group).Where("id = ?", group.ID).Exec(ctx); err != nil {
+			return err
+		}
+
+		if group.ComplementItem != nil {
+			if _, err := tx.NewUpdate().Model(group.ComplementItem).Where("id = ?", group.ComplementItem.ID).Exec(ctx); err != nil {
+				return err
+			}
+		}
+
+		for j := range group.Items {
+			item := &group.Items[j]
+			if _, err := tx.NewUpdate().Model(item).Where("id = ?", item.ID).Exec(ctx); err != nil {
+				return err
+			}
+
+			for k := range item.AdditionalItems {
+				if _, err := tx.NewUpdate().Model(&item.AdditionalItems[k]).Where("id = ?", item.AdditionalItems[k].ID).Exec(ctx); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package orderrepositorybun
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	groupitementity "github.com/willjrcom/sales-backend-go/internal/domain/group_item"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+)
+
+type EtaRepositoryBun struct {
+	mu sync.Mutex
+	db *bun.DB
+}
+
+func NewEtaRepositoryBun(db *bun.DB) *EtaRepositoryBun {
+	return &EtaRepositoryBun{db: db}
+}
+
+// GetPreparationStats averages the items made since the given time by category.
+func (r *EtaRepositoryBun) GetPreparationStats(ctx context.Context, since time.Time) ([]orderentity.PreparationStats, error) {
+	stats := []orderentity.PreparationStats{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	query := r.db.NewSelect().
+		TableExpr("items AS item").
+		Join("JOIN group_items AS group_item ON group_item.id = item.group_item_id").
+		ColumnExpr("group_item.category_id").
+		ColumnExpr("COUNT(item.id) AS samples").
+		ColumnExpr("AVG(EXTRACT(EPOCH FROM item.started_at - item.pending_at)) AS wait_seconds").
+		ColumnExpr("AVG(EXTRACT(EPOCH FROM item.ready_at - item.started_at)) AS preparation_seconds").
+		Where("item.pending_at IS NOT NULL").
+		Where("item.started_at IS NOT NULL").
+		Where("item.ready_at >= ?", since).
+		Group("group_item.category_id")
+
+	if err := query.Scan(ctx, &stats); err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// GetTravelStats averages the deliveries made since the given time by zone.
+func (r *EtaRepositoryBun) GetTravelStats(ctx context.Context, since time.Time) ([]orderentity.TravelStats, error) {
+	stats := []orderentity.TravelStats{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	query := r.db.NewSelect().
+		TableExpr("delivery_orders AS delivery").
+		ColumnExpr("delivery.delivery_zone_id").
+		ColumnExpr("COUNT(delivery.id) AS samples").
+		ColumnExpr("AVG(EXTRACT(EPOCH FROM delivery.delivered_at - delivery.launched_at)) AS seconds").
+		Where("delivery.launched_at IS NOT NULL").
+		Where("delivery.delivered_at >= ?", since).
+		Group("delivery.delivery_zone_id")
+
+	if err := query.Scan(ctx, &stats); err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// GetKitchenQueue returns the pending time of the orders with groups not ready yet.
+func (r *EtaRepositoryBun) GetKitchenQueue(ctx context.Context) ([]time.Time, error) {
+	queue := []time.Time{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	query := r.db.NewSelect().
+		TableExpr("group_items AS group_item").
+		ColumnExpr("MIN(group_item.pending_at)").
+		Where("group_item.status IN (?)", bun.In([]groupitementity.StatusGroupItem{groupitementity.StatusGroupPending, groupitementity.StatusGroupStarted})).
+		Where("group_item.pending_at IS NOT NULL").
+		Group("group_item.order_id")
+
+	if err := query.Scan(ctx, &queue); err != nil {
+		return nil, err
+	}
+
+	return queue, nil
+}
@@ -189,3 +189,57 @@ func (r *OrderRepositoryBun) AddPaymentOrder(ctx context.Context, payment *order
 
 	return nil
 }
+
+func (r *OrderRepositoryBun) UpdatePaymentOrders(ctx context.Context, payments []orderentity.PaymentOrder) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
+
+	if err != nil {
+		return err
+	}
+
+	for i := range payments {
+		if _, err := tx.NewUpdate().Model(&payments[i]).Where("id = ?", payments[i].ID).Exec(ctx); err != nil {
+			if errRollBack := tx.Rollback(); errRollBack != nil {
+				return errRollBack
+			}
+
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// GetOrdersByClientId returns the orders delivered to the client or with loyalty points of the client, newest first.
+func (r *OrderRepositoryBun) GetOrdersByClientId(ctx context.Context, clientID string) ([]orderentity.Order, error) {
+	orders := []orderentity.Order{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	query := r.db.NewSelect().Model(&orders).Relation("Groups.Items").Relation("Payments").Relation("Table").Relation("Delivery").Relation("Pickup").
+		Where("\"order\".id IN (SELECT order_id FROM delivery_orders WHERE client_id = ?)", clientID).
+		WhereOr("\"order\".id IN (SELECT order_id FROM loyalty_transactions WHERE client_id = ?)", clientID).
+		Order("order.created_at DESC")
+
+	if err := query.Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	for i := range orders {
+		orders[i].CalculateTotalPrice()
+	}
+
+	return orders, nil
+}
@@ -98,3 +98,24 @@ func (r *TableOrderRepositoryBun) GetAllTableOrders(ctx context.Context) (tables
 
 	return tables, err
 }
+
+// GetOpenTableOrderByTableID returns the last table order of the table with the order still staging or pending.
+func (r *TableOrderRepositoryBun) GetOpenTableOrderByTableID(ctx context.Context, tableID string) (*orderentity.TableOrder, error) {
+	table := &orderentity.TableOrder{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	openOrders := r.db.NewSelect().Model((*orderentity.Order)(nil)).Column("id").
+		Where("status IN (?)", bun.In([]orderentity.StatusOrder{orderentity.OrderStatusStaging, orderentity.OrderStatusPending}))
+
+	if err := r.db.NewSelect().Model(table).Where("table_id = ?", tableID).Where("order_id IN (?)", openOrders).Order("created_at DESC").Limit(1).Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return table, nil
+}
@@ -0,0 +1,184 @@
+package pricelistrepositorybun
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	productentity "github.com/willjrcom/sales-backend-go/internal/domain/product"
+)
+
+type PriceListRepositoryBun struct {
+	mu sync.Mutex
+	db *bun.DB
+}
+
+func NewPriceListRepositoryBun(db *bun.DB) *PriceListRepositoryBun {
+	return &PriceListRepositoryBun{db: db}
+}
+
+func (r *PriceListRepositoryBun) RegisterPriceList(ctx context.Context, l *productentity.PriceList) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.NewInsert().Model(l).Exec(ctx); err != nil {
+		if errRollBack := tx.Rollback(); errRollBack != nil {
+			return errRollBack
+		}
+
+		return err
+	}
+
+	return r.updateRules(ctx, tx, l)
+}
+
+func (r *PriceListRepositoryBun) UpdatePriceList(ctx context.Context, l *productentity.PriceList) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.NewUpdate().Model(l).Where("id = ?", l.ID).Exec(ctx); err != nil {
+		if errRollBack := tx.Rollback(); errRollBack != nil {
+			return errRollBack
+		}
+
+		return err
+	}
+
+	return r.updateRules(ctx, tx, l)
+}
+
+func (r *PriceListRepositoryBun) updateRules(ctx context.Context, tx bun.Tx, l *productentity.PriceList) error {
+	if _, err := tx.NewDelete().Model(&productentity.PriceRule{}).Where("price_list_id = ?", l.ID).Exec(ctx); err != nil {
+		if errRollBack := tx.Rollback(); errRollBack != nil {
+			return errRollBack
+		}
+
+		return err
+	}
+
+	if len(l.Rules) != 0 {
+		if _, err := tx.NewInsert().Model(&l.Rules).Exec(ctx); err != nil {
+			if errRollBack := tx.Rollback(); errRollBack != nil {
+				return errRollBack
+			}
+
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *PriceListRepositoryBun) DeletePriceList(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.NewDelete().Model(&productentity.PriceRule{}).Where("price_list_id = ?", id).Exec(ctx); err != nil {
+		if errRollBack := tx.Rollback(); errRollBack != nil {
+			return errRollBack
+		}
+
+		return err
+	}
+
+	if _, err := tx.NewDelete().Model(&productentity.PriceList{}).Where("id = ?", id).Exec(ctx); err != nil {
+		if errRollBack := tx.Rollback(); errRollBack != nil {
+			return errRollBack
+		}
+
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *PriceListRepositoryBun) GetPriceListById(ctx context.Context, id string) (*productentity.PriceList, error) {
+	priceList := &productentity.PriceList{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(priceList).Where("price_list.id = ?", id).Relation("Rules").Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return priceList, nil
+}
+
+func (r *PriceListRepositoryBun) GetAllPriceLists(ctx context.Context) ([]productentity.PriceList, error) {
+	priceLists := []productentity.PriceList{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(&priceLists).Relation("Rules").Order("priority DESC").Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return priceLists, nil
+}
+
+func (r *PriceListRepositoryBun) GetActivePriceLists(ctx context.Context) ([]productentity.PriceList, error) {
+	priceLists := []productentity.PriceList{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(&priceLists).Where("is_active = ?", true).Relation("Rules").Order("priority DESC").Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return priceLists, nil
+}
@@ -0,0 +1,142 @@
+package productrepositorybun
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	productentity "github.com/willjrcom/sales-backend-go/internal/domain/product"
+)
+
+// ImportCatalog saves the categories with their sizes, quantities and process rules,
+// the products with their relations and the price changes in one transaction.
+func (r *ProductRepositoryBun) ImportCatalog(ctx context.Context, catalog *productentity.CatalogImport) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
+
+	if err != nil {
+		return err
+	}
+
+	if err := r.importCategories(ctx, tx, catalog); err != nil {
+		if errRollBack := tx.Rollback(); errRollBack != nil {
+			return errRollBack
+		}
+
+		return err
+	}
+
+	if err := r.importProducts(ctx, tx, catalog); err != nil {
+		if errRollBack := tx.Rollback(); errRollBack != nil {
+			return errRollBack
+		}
+
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *ProductRepositoryBun) importCategories(ctx context.Context, tx bun.Tx, catalog *productentity.CatalogImport) error {
+	for _, category := range catalog.NewCategories {
+		if _, err := tx.NewInsert().Model(category).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	for _, category := range catalog.UpdatedCategories {
+		if _, err := tx.NewUpdate().Model(category).Where("id = ?", category.ID).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	for _, size := range catalog.NewSizes {
+		if _, err := tx.NewInsert().Model(size).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	for _, size := range catalog.UpdatedSizes {
+		if _, err := tx.NewUpdate().Model(size).Where("id = ?", size.ID).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	for _, quantity := range catalog.NewQuantities {
+		if _, err := tx.NewInsert().Model(quantity).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	for _, rule := range catalog.NewProcessRules {
+		if _, err := tx.NewInsert().Model(rule).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	for _, rule := range catalog.UpdatedProcessRules {
+		if _, err := tx.NewUpdate().Model(rule).Where("id = ?", rule.ID).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	// Additional categories are replaced after every category exists.
+	for _, category := range catalog.Categories() {
+		if _, err := tx.NewDelete().Model(&productentity.CategoryToAdditional{}).Where("category_id = ?", category.ID).Exec(ctx); err != nil {
+			return err
+		}
+
+		for _, additional := range category.AdditionalCategories {
+			categoryToAdditional := &productentity.CategoryToAdditional{
+				CategoryID:           category.ID,
+				AdditionalCategoryID: additional.ID,
+			}
+
+			if _, err := tx.NewInsert().Model(categoryToAdditional).Exec(ctx); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *ProductRepositoryBun) importProducts(ctx context.Context, tx bun.Tx, catalog *productentity.CatalogImport) error {
+	for _, product := range catalog.NewProducts {
+		if _, err := tx.NewInsert().Model(product).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	for _, product := range catalog.UpdatedProducts {
+		if _, err := tx.NewUpdate().Model(product).Where("id = ?", product.ID).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	// Relations are replaced after every product exists, combo slots reference other products.
+	for _, product := range catalog.Products() {
+		if err := r.replaceRelations(ctx, tx, product); err != nil {
+			return err
+		}
+	}
+
+	if len(catalog.PriceChanges) != 0 {
+		if _, err := tx.NewInsert().Model(&catalog.PriceChanges).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
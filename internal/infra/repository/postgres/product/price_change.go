@@ -0,0 +1,91 @@
+package productrepositorybun
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	productentity "github.com/willjrcom/sales-backend-go/internal/domain/product"
+)
+
+type ProductPriceChangeRepositoryBun struct {
+	mu sync.Mutex
+	db *bun.DB
+}
+
+func NewProductPriceChangeRepositoryBun(db *bun.DB) *ProductPriceChangeRepositoryBun {
+	return &ProductPriceChangeRepositoryBun{db: db}
+}
+
+func (r *ProductPriceChangeRepositoryBun) RegisterPriceChange(ctx context.Context, c *productentity.ProductPriceChange) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	if _, err := r.db.NewInsert().Model(c).Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *ProductPriceChangeRepositoryBun) UpdatePriceChange(ctx context.Context, c *productentity.ProductPriceChange) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	if _, err := r.db.NewUpdate().Model(c).Where("id = ?", c.ID).Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *ProductPriceChangeRepositoryBun) GetPriceChangesByProductId(ctx context.Context, productID string) ([]productentity.ProductPriceChange, error) {
+	changes := []productentity.ProductPriceChange{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(&changes).Where("product_id = ?", productID).Order("effective_at DESC").Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+func (r *ProductPriceChangeRepositoryBun) GetPendingPriceChanges(ctx context.Context, until time.Time) ([]productentity.ProductPriceChange, error) {
+	changes := []productentity.ProductPriceChange{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	// changes of deleted products are never applied
+	query := r.db.NewSelect().Model(&changes).
+		Where("applied_at IS NULL").
+		Where("effective_at <= ?", until).
+		Where("EXISTS (SELECT 1 FROM products WHERE products.id = product_price_change.product_id)").
+		Order("effective_at ASC")
+
+	if err := query.Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
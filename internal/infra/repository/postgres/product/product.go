@@ -2,6 +2,7 @@ package productrepositorybun
 
 import (
 	"context"
+	"database/sql"
 	"sync"
 
 	"github.com/uptrace/bun"
@@ -26,11 +27,21 @@ func (r *ProductRepositoryBun) RegisterProduct(ctx context.Context, p *producten
 		return err
 	}
 
-	if _, err := r.db.NewInsert().Model(p).Exec(ctx); err != nil {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
+
+	if err != nil {
 		return err
 	}
 
-	return nil
+	if _, err := tx.NewInsert().Model(p).Exec(ctx); err != nil {
+		if errRollBack := tx.Rollback(); errRollBack != nil {
+			return errRollBack
+		}
+
+		return err
+	}
+
+	return r.updateRelations(ctx, tx, p)
 }
 
 func (r *ProductRepositoryBun) UpdateProduct(ctx context.Context, p *productentity.Product) error {
@@ -41,13 +52,155 @@ func (r *ProductRepositoryBun) UpdateProduct(ctx context.Context, p *productenti
 		return err
 	}
 
-	if _, err := r.db.NewUpdate().Model(p).Where("id = ?", p.ID).Exec(ctx); err != nil {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.NewUpdate().Model(p).Where("id = ?", p.ID).Exec(ctx); err != nil {
+		if errRollBack := tx.Rollback(); errRollBack != nil {
+			return errRollBack
+		}
+
+		return err
+	}
+
+	return r.updateRelations(ctx, tx, p)
+}
+
+// UpdateProductWithPriceChange updates the product and records its price change in one transaction,
+// a scheduled change already registered is marked as applied.
+func (r *ProductRepositoryBun) UpdateProductWithPriceChange(ctx context.Context, p *productentity.Product, change *productentity.ProductPriceChange) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.NewUpdate().Model(p).Where("id = ?", p.ID).Exec(ctx); err != nil {
+		if errRollBack := tx.Rollback(); errRollBack != nil {
+			return errRollBack
+		}
+
+		return err
+	}
+
+	if _, err := tx.NewInsert().Model(change).
+		On("CONFLICT (id) DO UPDATE").
+		Set("old_price = EXCLUDED.old_price").
+		Set("old_cost = EXCLUDED.old_cost").
+		Set("applied_at = EXCLUDED.applied_at").
+		Exec(ctx); err != nil {
+		if errRollBack := tx.Rollback(); errRollBack != nil {
+			return errRollBack
+		}
+
 		return err
 	}
 
+	return r.updateRelations(ctx, tx, p)
+}
+
+func (r *ProductRepositoryBun) updateRelations(ctx context.Context, tx bun.Tx, p *productentity.Product) error {
+	if err := r.replaceRelations(ctx, tx, p); err != nil {
+		if errRollBack := tx.Rollback(); errRollBack != nil {
+			return errRollBack
+		}
+
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *ProductRepositoryBun) replaceRelations(ctx context.Context, tx bun.Tx, p *productentity.Product) error {
+	if err := r.deleteRelations(ctx, tx, p.ID.String()); err != nil {
+		return err
+	}
+
+	if p.IsCombo() && len(p.ComboSlots) != 0 {
+		if _, err := tx.NewInsert().Model(&p.ComboSlots).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	if p.HasVariants() {
+		if _, err := tx.NewInsert().Model(&p.Variants).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+func (r *ProductRepositoryBun) deleteRelations(ctx context.Context, tx bun.Tx, id string) error {
+	if _, err := tx.NewDelete().Model(&productentity.ComboSlot{}).Where("product_id = ?", id).Exec(ctx); err != nil {
+		return err
+	}
+
+	if _, err := tx.NewDelete().Model(&productentity.ProductVariant{}).Where("product_id = ?", id).Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MergeProducts updates the product with its variants and deletes the merged products in one transaction.
+func (r *ProductRepositoryBun) MergeProducts(ctx context.Context, p *productentity.Product, mergedIDs []string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.NewUpdate().Model(p).Where("id = ?", p.ID).Exec(ctx); err != nil {
+		if errRollBack := tx.Rollback(); errRollBack != nil {
+			return errRollBack
+		}
+
+		return err
+	}
+
+	for _, id := range mergedIDs {
+		if err := r.deleteRelations(ctx, tx, id); err != nil {
+			if errRollBack := tx.Rollback(); errRollBack != nil {
+				return errRollBack
+			}
+
+			return err
+		}
+
+		if _, err := tx.NewDelete().Model(&productentity.Product{}).Where("id = ?", id).Exec(ctx); err != nil {
+			if errRollBack := tx.Rollback(); errRollBack != nil {
+				return errRollBack
+			}
+
+			return err
+		}
+	}
+
+	return r.updateRelations(ctx, tx, p)
+}
+
 func (r *ProductRepositoryBun) DeleteProduct(ctx context.Context, id string) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
@@ -56,7 +209,29 @@ func (r *ProductRepositoryBun) DeleteProduct(ctx context.Context, id string) err
 		return err
 	}
 
-	if _, err := r.db.NewDelete().Model(&productentity.Product{}).Where("id = ?", id).Exec(ctx); err != nil {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.NewDelete().Model(&productentity.Product{}).Where("id = ?", id).Exec(ctx); err != nil {
+		if errRollBack := tx.Rollback(); errRollBack != nil {
+			return errRollBack
+		}
+
+		return err
+	}
+
+	if err := r.deleteRelations(ctx, tx, id); err != nil {
+		if errRollBack := tx.Rollback(); errRollBack != nil {
+			return errRollBack
+		}
+
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
 		return err
 	}
 
@@ -73,7 +248,7 @@ func (r *ProductRepositoryBun) GetProductById(ctx context.Context, id string) (*
 		return nil, err
 	}
 
-	if err := r.db.NewSelect().Model(product).Where("product.id = ?", id).Relation("Category").Relation("Size").Scan(ctx); err != nil {
+	if err := r.db.NewSelect().Model(product).Where("product.id = ?", id).Relation("Category").Relation("Size").Relation("ComboSlots").Relation("Variants.Size").Scan(ctx); err != nil {
 		return nil, err
 	}
 
@@ -90,7 +265,7 @@ func (r *ProductRepositoryBun) GetProductByCode(ctx context.Context, code string
 		return nil, err
 	}
 
-	if err := r.db.NewSelect().Model(product).Where("product.code = ?", code).Relation("Category").Relation("Size").Scan(ctx); err != nil {
+	if err := r.db.NewSelect().Model(product).Where("product.code = ?", code).Relation("Category").Relation("Size").Relation("ComboSlots").Relation("Variants.Size").Scan(ctx); err != nil {
 		return nil, err
 	}
 
@@ -107,9 +282,90 @@ func (r *ProductRepositoryBun) GetAllProducts(ctx context.Context) ([]productent
 		return nil, err
 	}
 
-	if err := r.db.NewSelect().Model(&products).Relation("Category").Relation("Size").Scan(ctx); err != nil {
+	if err := r.db.NewSelect().Model(&products).Relation("Category").Relation("Size").Relation("ComboSlots").Relation("Variants.Size").Scan(ctx); err != nil {
 		return nil, err
 	}
 
 	return products, nil
 }
+
+// ApplyProductBatch updates price, cost and availability of the products and records the batch in one transaction.
+func (r *ProductRepositoryBun) ApplyProductBatch(ctx context.Context, batch *productentity.ProductBatch, products []productentity.Product, priceChanges []productentity.ProductPriceChange) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
+
+	if err != nil {
+		return err
+	}
+
+	for i := range products {
+		if err := r.updateBatchProduct(ctx, tx, &products[i]); err != nil {
+			if errRollBack := tx.Rollback(); errRollBack != nil {
+				return errRollBack
+			}
+
+			return err
+		}
+	}
+
+	if len(priceChanges) != 0 {
+		if _, err := tx.NewInsert().Model(&priceChanges).Exec(ctx); err != nil {
+			if errRollBack := tx.Rollback(); errRollBack != nil {
+				return errRollBack
+			}
+
+			return err
+		}
+	}
+
+	if _, err := tx.NewInsert().Model(batch).Exec(ctx); err != nil {
+		if errRollBack := tx.Rollback(); errRollBack != nil {
+			return errRollBack
+		}
+
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *ProductRepositoryBun) updateBatchProduct(ctx context.Context, tx bun.Tx, p *productentity.Product) error {
+	if _, err := tx.NewUpdate().Model(p).Column("price", "cost", "is_available").Where("id = ?", p.ID).Exec(ctx); err != nil {
+		return err
+	}
+
+	for i := range p.Variants {
+		if _, err := tx.NewUpdate().Model(&p.Variants[i]).Column("price", "cost").Where("id = ?", p.Variants[i].ID).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *ProductRepositoryBun) GetAllProductBatches(ctx context.Context) ([]productentity.ProductBatch, error) {
+	batches := []productentity.ProductBatch{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(&batches).Order("created_at DESC").Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return batches, nil
+}
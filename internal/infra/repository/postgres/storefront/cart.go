@@ -0,0 +1,52 @@
+package storefrontrepositorybun
+
+import (
+	"context"
+	"sync"
+
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	storefrontentity "github.com/willjrcom/sales-backend-go/internal/domain/storefront"
+)
+
+type CartRepositoryBun struct {
+	mu sync.Mutex
+	db *bun.DB
+}
+
+func NewCartRepositoryBun(db *bun.DB) *CartRepositoryBun {
+	return &CartRepositoryBun{db: db}
+}
+
+// SaveCart inserts the cart of the client or replaces its items.
+func (r *CartRepositoryBun) SaveCart(ctx context.Context, cart *storefrontentity.Cart) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	if _, err := r.db.NewInsert().Model(cart).On("CONFLICT (id) DO UPDATE").Set("items = EXCLUDED.items").Set("updated_at = now()").Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *CartRepositoryBun) GetCartByClientID(ctx context.Context, clientID string) (*storefrontentity.Cart, error) {
+	cart := &storefrontentity.Cart{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(cart).Where("client_id = ?", clientID).Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return cart, nil
+}
@@ -0,0 +1,84 @@
+package storefrontrepositorybun
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"time"
+
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	storefrontentity "github.com/willjrcom/sales-backend-go/internal/domain/storefront"
+)
+
+type OtpRepositoryBun struct {
+	mu sync.Mutex
+	db *bun.DB
+}
+
+func NewOtpRepositoryBun(db *bun.DB) *OtpRepositoryBun {
+	return &OtpRepositoryBun{db: db}
+}
+
+func (r *OtpRepositoryBun) RegisterOtpCode(ctx context.Context, otp *storefrontentity.OtpCode) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	if _, err := r.db.NewInsert().Model(otp).Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// VerifyLastOtpCode verifies the last code of the phone with the row locked, parallel attempts are counted one by one.
+// The attempt is saved even when the code is wrong, the error of the verification is returned after the commit.
+func (r *OtpRepositoryBun) VerifyLastOtpCode(ctx context.Context, ddd string, number string, code string, now time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
+
+	if err != nil {
+		return err
+	}
+
+	errVerify, err := r.verifyLastOtpCode(ctx, tx, ddd, number, code, now)
+
+	if err != nil {
+		if errRollBack := tx.Rollback(); errRollBack != nil {
+			return errRollBack
+		}
+
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	return errVerify
+}
+
+func (r *OtpRepositoryBun) verifyLastOtpCode(ctx context.Context, tx bun.Tx, ddd string, number string, code string, now time.Time) (errVerify error, err error) {
+	otp := &storefrontentity.OtpCode{}
+	if err := tx.NewSelect().Model(otp).Where("ddd = ? AND number = ?", ddd, number).Order("created_at DESC").Limit(1).For("UPDATE").Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	errVerify = otp.Verify(code, now)
+
+	if _, err := tx.NewUpdate().Model(otp).Where("id = ?", otp.ID).Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	return errVerify, nil
+}
@@ -0,0 +1,58 @@
+package cep
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+var (
+	ErrCepInvalid          = errors.New("cep is invalid")
+	ErrCepNotFound         = errors.New("cep not found")
+	ErrProviderUnavailable = errors.New("cep provider unavailable")
+)
+
+const cepLength = 8
+
+var cepSeparators = strings.NewReplacer("-", "", ".", "")
+
+type Cep struct {
+	Cep          string `json:"cep"`
+	Street       string `json:"logradouro"`
+	Complement   string `json:"complemento"`
+	Neighborhood string `json:"bairro"`
+	City         string `json:"localidade"`
+	State        string `json:"uf"`
+}
+
+// CepProvider looks up the address of a cep, the cep is validated before any lookup.
+type CepProvider interface {
+	Get(ctx context.Context, cep string) (*Cep, error)
+}
+
+// NormalizeCep returns the 8 digits of the cep.
+func NormalizeCep(cep string) (string, error) {
+	digits := cepSeparators.Replace(strings.TrimSpace(cep))
+
+	if len(digits) != cepLength {
+		return "", ErrCepInvalid
+	}
+
+	for _, r := range digits {
+		if r < '0' || r > '9' {
+			return "", ErrCepInvalid
+		}
+	}
+
+	return digits, nil
+}
+
+// FormatCep returns the cep as 00000-000.
+func FormatCep(cep string) (string, error) {
+	digits, err := NormalizeCep(cep)
+	if err != nil {
+		return "", err
+	}
+
+	return digits[:5] + "-" + digits[5:], nil
+}
@@ -0,0 +1,66 @@
+package cep
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeCep(t *testing.T) {
+	cep, err := FormatCep(" 01001000 ")
+	assert.Nil(t, err)
+	assert.Equal(t, "01001-000", cep)
+
+	cep, err = NormalizeCep("01.001-000")
+	assert.Nil(t, err)
+	assert.Equal(t, "01001000", cep)
+
+	_, err = NormalizeCep("0100100")
+	assert.ErrorIs(t, err, ErrCepInvalid)
+
+	_, err = NormalizeCep("01001-00a")
+	assert.ErrorIs(t, err, ErrCepInvalid)
+}
+
+func TestViaCepProvider(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/99999999/json/" {
+			w.Write([]byte(`{"erro": "true"}`))
+			return
+		}
+
+		assert.Equal(t, "/01001000/json/", r.URL.Path)
+		w.Write([]byte(`{"cep":"01001-000","logradouro":"Praça da Sé","bairro":"Sé","localidade":"São Paulo","uf":"SP"}`))
+	}))
+	defer server.Close()
+
+	provider := NewViaCepProvider(time.Second)
+	provider.url = server.URL + "/"
+
+	address, err := provider.Get(context.Background(), "01001-000")
+	assert.Nil(t, err)
+	assert.Equal(t, "Praça da Sé", address.Street)
+	assert.Equal(t, "SP", address.State)
+
+	_, err = provider.Get(context.Background(), "99999-999")
+	assert.ErrorIs(t, err, ErrCepNotFound)
+
+	server.Close()
+	_, err = provider.Get(context.Background(), "01001-000")
+	assert.ErrorIs(t, err, ErrProviderUnavailable)
+}
+
+func TestFixtureProvider(t *testing.T) {
+	provider := NewFixtureProvider(DefaultFixtures...)
+
+	address, err := provider.Get(context.Background(), "20040020")
+	assert.Nil(t, err)
+	assert.Equal(t, "RJ", address.State)
+
+	_, err = provider.Get(context.Background(), "99999-999")
+	assert.ErrorIs(t, err, ErrCepNotFound)
+}
@@ -0,0 +1,68 @@
+package cep
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const viaCepURL = "https://viacep.com.br/ws/"
+
+// ViaCepProvider looks up the cep on viacep.com.br.
+type ViaCepProvider struct {
+	client *http.Client
+	url    string
+}
+
+type viaCepResponse struct {
+	Cep
+	// viacep answers "erro": true, or "erro": "true" on newer versions, for unknown ceps
+	Erro any `json:"erro"`
+}
+
+func NewViaCepProvider(timeout time.Duration) *ViaCepProvider {
+	return &ViaCepProvider{
+		client: &http.Client{Timeout: timeout},
+		url:    viaCepURL,
+	}
+}
+
+func (p *ViaCepProvider) Get(ctx context.Context, cepString string) (*Cep, error) {
+	digits, err := NormalizeCep(cepString)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, p.url+digits+"/json/", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.client.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrProviderUnavailable, err.Error())
+	}
+	defer response.Body.Close()
+
+	switch {
+	case response.StatusCode == http.StatusBadRequest:
+		return nil, ErrCepInvalid
+	case response.StatusCode == http.StatusNotFound:
+		return nil, ErrCepNotFound
+	case response.StatusCode != http.StatusOK:
+		return nil, fmt.Errorf("%w: status %d", ErrProviderUnavailable, response.StatusCode)
+	}
+
+	body := &viaCepResponse{}
+	if err := json.NewDecoder(response.Body).Decode(body); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrProviderUnavailable, err.Error())
+	}
+
+	if body.Erro == true || body.Erro == "true" || body.Cep.Cep == "" {
+		return nil, ErrCepNotFound
+	}
+
+	return &body.Cep, nil
+}
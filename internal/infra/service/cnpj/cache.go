@@ -0,0 +1,59 @@
+package cnpj
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/willjrcom/sales-backend-go/pkg/document"
+)
+
+// CachedProvider keeps the companies found by the provider, errors are never cached.
+type CachedProvider struct {
+	mu       sync.Mutex
+	provider CnpjProvider
+	ttl      time.Duration
+	entries  map[string]cacheEntry
+	now      func() time.Time
+}
+
+type cacheEntry struct {
+	company   Cnpj
+	expiresAt time.Time
+}
+
+func NewCachedProvider(provider CnpjProvider, ttl time.Duration) *CachedProvider {
+	return &CachedProvider{
+		provider: provider,
+		ttl:      ttl,
+		entries:  map[string]cacheEntry{},
+		now:      time.Now,
+	}
+}
+
+func (p *CachedProvider) Get(ctx context.Context, cnpjString string) (*Cnpj, error) {
+	cnpjNumeros, err := document.NormalizeCnpj(cnpjString)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	entry, ok := p.entries[cnpjNumeros]
+	p.mu.Unlock()
+
+	if ok && p.now().Before(entry.expiresAt) {
+		company := entry.company
+		return &company, nil
+	}
+
+	company, err := p.provider.Get(ctx, cnpjNumeros)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	p.entries[cnpjNumeros] = cacheEntry{company: *company, expiresAt: p.now().Add(p.ttl)}
+	p.mu.Unlock()
+
+	return company, nil
+}
@@ -1,19 +1,17 @@
 package cnpj
 
 import (
-	"encoding/json"
+	"context"
 	"errors"
-	"io"
-	"net/http"
-	"regexp"
 )
 
 var (
-	ErrCNPJNotFound = errors.New("cnpj not found")
+	ErrCNPJNotFound          = errors.New("cnpj not found")
+	ErrProviderUnavailable   = errors.New("cnpj provider unavailable")
+	ErrProviderRateLimited   = errors.New("cnpj provider rate limited")
+	ErrProviderUnknownStatus = errors.New("cnpj provider returned an unknown status")
 )
 
-const url = "https://www.receitaws.com.br/v1/cnpj/"
-
 type Cnpj struct {
 	Cnpj         string `json:"cnpj"`
 	BusinessName string `json:"nome"`
@@ -26,33 +24,12 @@ type Cnpj struct {
 	Cep          string `json:"cep"`
 }
 
-func Get(cnpjString string) (*Cnpj, error) {
-	regexNumeros := regexp.MustCompile("[^0-9]")
-
-	// Remover caracteres não numéricos
-	cnpjNumeros := regexNumeros.ReplaceAllString(cnpjString, "")
-
-	response, err := http.Get(url + cnpjNumeros)
-	if err != nil {
-		return nil, err
-	}
-	defer response.Body.Close()
-
-	// Ler o corpo da response
-	body, err := io.ReadAll(response.Body)
-
-	if err != nil {
-		return nil, err
-	}
-
-	var cnpj Cnpj
-	if err := json.Unmarshal(body, &cnpj); err != nil {
-		return nil, err
-	}
-
-	if cnpj.Cnpj == "" {
-		return nil, ErrCNPJNotFound
-	}
+// CnpjProvider looks up the registration data of a company, the cnpj is validated before any lookup.
+type CnpjProvider interface {
+	Get(ctx context.Context, cnpj string) (*Cnpj, error)
+}
 
-	return &cnpj, nil
+// IsUnavailable is true when the lookup failed because of the provider, not because of the cnpj.
+func IsUnavailable(err error) bool {
+	return errors.Is(err, ErrProviderUnavailable) || errors.Is(err, ErrProviderRateLimited) || errors.Is(err, ErrProviderUnknownStatus)
 }
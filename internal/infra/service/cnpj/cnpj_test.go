@@ -0,0 +1,112 @@
+package cnpj
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/willjrcom/sales-backend-go/pkg/document"
+)
+
+func newTestReceitaWSProvider(handler http.HandlerFunc) (*ReceitaWSProvider, *httptest.Server) {
+	server := httptest.NewServer(handler)
+	provider := NewReceitaWSProvider(time.Second)
+	provider.url = server.URL + "/"
+	provider.retryWait = time.Millisecond
+	return provider, server
+}
+
+func TestReceitaWSProvider(t *testing.T) {
+	attempts := 0
+	provider, server := newTestReceitaWSProvider(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		assert.Equal(t, "/11222333000181", r.URL.Path)
+		w.Write([]byte(`{"status":"OK","cnpj":"11.222.333/0001-81","nome":"Pizzaria LTDA","fantasia":"Pizzaria"}`))
+	})
+	defer server.Close()
+
+	company, err := provider.Get(context.Background(), "11.222.333/0001-81")
+	assert.Nil(t, err)
+	assert.Equal(t, "Pizzaria", company.TradeName)
+	assert.Equal(t, 2, attempts)
+
+	_, err = provider.Get(context.Background(), "11.222.333/0001-82")
+	assert.EqualError(t, err, document.ErrCnpjInvalid.Error())
+	assert.Equal(t, 2, attempts)
+}
+
+func TestReceitaWSProviderErrors(t *testing.T) {
+	status := http.StatusOK
+	provider, server := newTestReceitaWSProvider(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		w.Write([]byte(`{"status":"ERROR","message":"CNPJ inválido"}`))
+	})
+	defer server.Close()
+
+	_, err := provider.Get(context.Background(), "11222333000181")
+	assert.ErrorIs(t, err, ErrCNPJNotFound)
+	assert.False(t, IsUnavailable(err))
+
+	status = http.StatusTooManyRequests
+	_, err = provider.Get(context.Background(), "11222333000181")
+	assert.ErrorIs(t, err, ErrProviderRateLimited)
+
+	status = http.StatusBadGateway
+	_, err = provider.Get(context.Background(), "11222333000181")
+	assert.ErrorIs(t, err, ErrProviderUnavailable)
+	assert.True(t, IsUnavailable(err))
+}
+
+type countingProvider struct {
+	CnpjProvider
+	calls int
+}
+
+func (p *countingProvider) Get(ctx context.Context, cnpj string) (*Cnpj, error) {
+	p.calls++
+	return p.CnpjProvider.Get(ctx, cnpj)
+}
+
+func TestCachedProvider(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	fixtures := &countingProvider{CnpjProvider: NewFixtureProvider(DefaultFixtures...)}
+	provider := NewCachedProvider(fixtures, time.Hour)
+	provider.now = func() time.Time { return now }
+
+	company, err := provider.Get(context.Background(), "12ABC34501DE35")
+	assert.Nil(t, err)
+	assert.Equal(t, "Lanchonete Alfanumerica", company.TradeName)
+
+	_, err = provider.Get(context.Background(), "12.abc.345/01de-35")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, fixtures.calls)
+
+	now = now.Add(time.Hour)
+	_, err = provider.Get(context.Background(), "12ABC34501DE35")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, fixtures.calls)
+
+	_, err = provider.Get(context.Background(), "11.444.777/0001-61")
+	assert.ErrorIs(t, err, ErrCNPJNotFound)
+}
+
+func TestReceitaWSRetryAfter(t *testing.T) {
+	provider := NewReceitaWSProvider(time.Second)
+	response := &http.Response{Header: http.Header{}}
+
+	assert.Equal(t, receitaWSRetryWait, provider.retryAfter(response))
+
+	response.Header.Set("Retry-After", "5")
+	assert.Equal(t, 5*time.Second, provider.retryAfter(response))
+
+	response.Header.Set("Retry-After", "3600")
+	assert.Equal(t, receitaWSMaxRetryWait, provider.retryAfter(response))
+}
@@ -0,0 +1,64 @@
+package cnpj
+
+import (
+	"context"
+
+	"github.com/willjrcom/sales-backend-go/pkg/document"
+)
+
+// DefaultFixtures are the companies found by the fixture provider on local environments.
+var DefaultFixtures = []Cnpj{
+	{
+		Cnpj:         "11.222.333/0001-81",
+		BusinessName: "Pizzaria Exemplo LTDA",
+		TradeName:    "Pizzaria Exemplo",
+		Street:       "Rua das Flores",
+		Number:       "100",
+		City:         "São Paulo",
+		Neighborhood: "Centro",
+		State:        "SP",
+		Cep:          "01001-000",
+	},
+	{
+		Cnpj:         "12.ABC.345/01DE-35",
+		BusinessName: "Lanchonete Alfanumerica LTDA",
+		TradeName:    "Lanchonete Alfanumerica",
+		Street:       "Avenida Brasil",
+		Number:       "2000",
+		City:         "Campinas",
+		Neighborhood: "Jardim Brasil",
+		State:        "SP",
+		Cep:          "13070-000",
+	},
+}
+
+// FixtureProvider finds the companies on a fixed list, it is used on local environments and tests.
+type FixtureProvider struct {
+	companies map[string]Cnpj
+}
+
+func NewFixtureProvider(fixtures ...Cnpj) *FixtureProvider {
+	p := &FixtureProvider{companies: map[string]Cnpj{}}
+
+	for _, fixture := range fixtures {
+		if cnpjNumeros, err := document.NormalizeCnpj(fixture.Cnpj); err == nil {
+			p.companies[cnpjNumeros] = fixture
+		}
+	}
+
+	return p
+}
+
+func (p *FixtureProvider) Get(_ context.Context, cnpjString string) (*Cnpj, error) {
+	cnpjNumeros, err := document.NormalizeCnpj(cnpjString)
+	if err != nil {
+		return nil, err
+	}
+
+	company, ok := p.companies[cnpjNumeros]
+	if !ok {
+		return nil, ErrCNPJNotFound
+	}
+
+	return &company, nil
+}
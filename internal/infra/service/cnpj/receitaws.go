@@ -0,0 +1,113 @@
+package cnpj
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/willjrcom/sales-backend-go/pkg/document"
+)
+
+const receitaWSURL = "https://www.receitaws.com.br/v1/cnpj/"
+
+// The free plan of receitaws allows 3 lookups per minute, a longer Retry-After never holds the request more than a minute.
+const (
+	receitaWSMaxAttempts  = 3
+	receitaWSRetryWait    = 20 * time.Second
+	receitaWSMaxRetryWait = time.Minute
+)
+
+// ReceitaWSProvider looks up the cnpj on receitaws.com.br, retrying when the rate limit is reached.
+type ReceitaWSProvider struct {
+	client       *http.Client
+	url          string
+	maxAttempts  int
+	retryWait    time.Duration
+	maxRetryWait time.Duration
+}
+
+type receitaWSResponse struct {
+	Cnpj
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+func NewReceitaWSProvider(timeout time.Duration) *ReceitaWSProvider {
+	return &ReceitaWSProvider{
+		client:       &http.Client{Timeout: timeout},
+		url:          receitaWSURL,
+		maxAttempts:  receitaWSMaxAttempts,
+		retryWait:    receitaWSRetryWait,
+		maxRetryWait: receitaWSMaxRetryWait,
+	}
+}
+
+func (p *ReceitaWSProvider) Get(ctx context.Context, cnpjString string) (*Cnpj, error) {
+	// Rejeitar cnpj inválido antes da consulta
+	cnpjNumeros, err := document.NormalizeCnpj(cnpjString)
+	if err != nil {
+		return nil, err
+	}
+
+	for attempt := 1; ; attempt++ {
+		cnpj, wait, err := p.get(ctx, cnpjNumeros)
+
+		if err != ErrProviderRateLimited || attempt >= p.maxAttempts {
+			return cnpj, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+// get does one lookup, returning how long to wait before the next attempt when rate limited.
+func (p *ReceitaWSProvider) get(ctx context.Context, cnpjNumeros string) (*Cnpj, time.Duration, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, p.url+cnpjNumeros, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	response, err := p.client.Do(request)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w: %s", ErrProviderUnavailable, err.Error())
+	}
+	defer response.Body.Close()
+
+	switch {
+	case response.StatusCode == http.StatusTooManyRequests:
+		return nil, p.retryAfter(response), ErrProviderRateLimited
+	case response.StatusCode == http.StatusNotFound:
+		return nil, 0, ErrCNPJNotFound
+	case response.StatusCode >= http.StatusInternalServerError:
+		return nil, 0, fmt.Errorf("%w: status %d", ErrProviderUnavailable, response.StatusCode)
+	case response.StatusCode != http.StatusOK:
+		return nil, 0, fmt.Errorf("%w: status %d", ErrProviderUnknownStatus, response.StatusCode)
+	}
+
+	body := &receitaWSResponse{}
+	if err := json.NewDecoder(response.Body).Decode(body); err != nil {
+		return nil, 0, fmt.Errorf("%w: %s", ErrProviderUnknownStatus, err.Error())
+	}
+
+	// receitaws answers 200 with status ERROR for invalid or unknown cnpj
+	if body.Status == "ERROR" || body.Cnpj.Cnpj == "" {
+		return nil, 0, ErrCNPJNotFound
+	}
+
+	return &body.Cnpj, 0, nil
+}
+
+func (p *ReceitaWSProvider) retryAfter(response *http.Response) time.Duration {
+	if seconds, err := strconv.Atoi(response.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+		return min(time.Duration(seconds)*time.Second, p.maxRetryWait)
+	}
+
+	return p.retryWait
+}
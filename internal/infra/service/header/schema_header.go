@@ -24,3 +24,13 @@ func GetIDTokenHeader(r *http.Request) (string, error) {
 
 	return idToken, nil
 }
+
+func GetCustomerTokenHeader(r *http.Request) (string, error) {
+	customerToken := r.Header.Get("customer-token")
+
+	if customerToken == "" {
+		return "", errors.New("customer-token is required")
+	}
+
+	return customerToken, nil
+}
@@ -12,6 +12,10 @@ import (
 
 var secretKey = "sua_chave_secreta"
 
+// publicSecretKey signs the tokens of the public routes, like table, customer and order tracking,
+// they never pass as a token of a logged user.
+var publicSecretKey = "sua_chave_secreta_publica"
+
 func CreateAccessToken(user *companyentity.User) (string, error) {
 
 	claims := jwt.MapClaims{
@@ -53,6 +57,42 @@ func ValidateToken(ctx context.Context, tokenString string) (*jwt.Token, error)
 	})
 }
 
+// ValidateIDToken only accepts the id token of a logged user, the access token has no schema.
+func ValidateIDToken(ctx context.Context, tokenString string) (*jwt.Token, error) {
+	token, err := ValidateToken(ctx, tokenString)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if sub, _ := token.Claims.(jwt.MapClaims)["sub"].(string); sub != "id-token" {
+		return nil, jwt.ErrSignatureInvalid
+	}
+
+	return token, nil
+}
+
+// validatePublicToken parses a token signed with the public secret and checks its subject.
+func validatePublicToken(tokenString string, sub string) (jwt.MapClaims, error) {
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, jwt.ErrSignatureInvalid
+		}
+		return []byte(publicSecretKey), nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	claims := token.Claims.(jwt.MapClaims)
+	if tokenSub, _ := claims["sub"].(string); tokenSub != sub {
+		return nil, jwt.ErrSignatureInvalid
+	}
+
+	return claims, nil
+}
+
 func GetSchemasFromToken(token *jwt.Token) []interface{} {
 	return token.Claims.(jwt.MapClaims)["available_user_schemas"].([]interface{})
 }
@@ -73,3 +113,91 @@ func GetUserFromToken(token *jwt.Token) companyentity.User {
 		},
 	}
 }
+
+// CreateTableToken signs the public token of the table QR code, it has no expiration and is invalidated by rotating the nonce.
+func CreateTableToken(schema string, tableID uuid.UUID, nonce string) (string, error) {
+	claims := jwt.MapClaims{
+		"current_schema": schema,
+		"table_id":       tableID.String(),
+		"nonce":          nonce,
+		"sub":            "table-token",
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString([]byte(publicSecretKey))
+}
+
+func ValidateTableToken(ctx context.Context, tokenString string) (schema string, tableID uuid.UUID, nonce string, err error) {
+	claims, err := validatePublicToken(tokenString, "table-token")
+
+	if err != nil {
+		return "", uuid.Nil, "", err
+	}
+
+	schema, _ = claims["current_schema"].(string)
+	nonce, _ = claims["nonce"].(string)
+	id, _ := claims["table_id"].(string)
+
+	if tableID, err = uuid.Parse(id); err != nil {
+		return "", uuid.Nil, "", err
+	}
+
+	return schema, tableID, nonce, nil
+}
+
+// CreateCustomerToken identifies the phone verified by otp on the storefront of the company.
+func CreateCustomerToken(schema string, ddd string, number string) (string, error) {
+	claims := jwt.MapClaims{
+		"current_schema": schema,
+		"ddd":            ddd,
+		"number":         number,
+		"sub":            "customer-token",
+		"exp":            time.Now().Add(time.Hour * 24 * 30).Unix(),
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString([]byte(publicSecretKey))
+}
+
+func ValidateCustomerToken(ctx context.Context, tokenString string) (schema string, ddd string, number string, err error) {
+	claims, err := validatePublicToken(tokenString, "customer-token")
+
+	if err != nil {
+		return "", "", "", err
+	}
+
+	schema, _ = claims["current_schema"].(string)
+	ddd, _ = claims["ddd"].(string)
+	number, _ = claims["number"].(string)
+	return schema, ddd, number, nil
+}
+
+// CreateOrderTrackingToken signs the public link of the order tracking page, valid for a week.
+func CreateOrderTrackingToken(schema string, orderID uuid.UUID) (string, error) {
+	claims := jwt.MapClaims{
+		"current_schema": schema,
+		"order_id":       orderID.String(),
+		"sub":            "order-tracking-token",
+		"exp":            time.Now().Add(time.Hour * 24 * 7).Unix(),
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString([]byte(publicSecretKey))
+}
+
+func ValidateOrderTrackingToken(ctx context.Context, tokenString string) (schema string, orderID uuid.UUID, err error) {
+	claims, err := validatePublicToken(tokenString, "order-tracking-token")
+
+	if err != nil {
+		return "", uuid.Nil, err
+	}
+
+	schema, _ = claims["current_schema"].(string)
+	id, _ := claims["order_id"].(string)
+
+	if orderID, err = uuid.Parse(id); err != nil {
+		return "", uuid.Nil, err
+	}
+
+	return schema, orderID, nil
+}
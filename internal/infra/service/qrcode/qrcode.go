@@ -0,0 +1,15 @@
+package qrcodeservice
+
+import (
+	qrcode "github.com/skip2/go-qrcode"
+)
+
+var (
+	imageSize = 512
+)
+
+// EncodeSelfOrderURL generates the png image printed on the table, it opens the self-ordering page of the token.
+// The base url is the self-ordering page of the frontend, set by the self-order-url flag.
+func EncodeSelfOrderURL(baseURL string, token string) ([]byte, error) {
+	return qrcode.Encode(baseURL+token, qrcode.Medium, imageSize)
+}
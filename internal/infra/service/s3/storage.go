@@ -0,0 +1,86 @@
+package s3
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/google/uuid"
+)
+
+const DefaultBucket = "sales-backend-golang"
+
+// Storage keeps the uploaded files, it returns the key of the object.
+type Storage interface {
+	Upload(ctx context.Context, file io.Reader) (string, error)
+}
+
+// BucketStorage uploads the files to a public bucket.
+type BucketStorage struct {
+	bucket string
+}
+
+func NewBucketStorage(bucket string) *BucketStorage {
+	return &BucketStorage{bucket: bucket}
+}
+
+func (s *BucketStorage) Upload(ctx context.Context, file io.Reader) (string, error) {
+	cfg, err := config.LoadDefaultConfig(ctx)
+
+	if err != nil {
+		return "", err
+	}
+
+	client := s3.NewFromConfig(cfg)
+	key := uuid.NewString()
+
+	uploadInput := &s3.PutObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+		Body:   file,
+		ACL:    types.ObjectCannedACLPublicRead, // Permite que o objeto seja lido publicamente
+	}
+
+	if _, err := client.PutObject(ctx, uploadInput); err != nil {
+		return "", err
+	}
+
+	return key, nil
+}
+
+// FakeStorage keeps the files in memory, it is used on local environments and tests.
+type FakeStorage struct {
+	mu    sync.Mutex
+	files map[string][]byte
+}
+
+func NewFakeStorage() *FakeStorage {
+	return &FakeStorage{files: map[string][]byte{}}
+}
+
+func (s *FakeStorage) Upload(_ context.Context, file io.Reader) (string, error) {
+	content, err := io.ReadAll(file)
+
+	if err != nil {
+		return "", err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := uuid.NewString()
+	s.files[key] = content
+	return key, nil
+}
+
+func (s *FakeStorage) Get(key string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	content, ok := s.files[key]
+	return content, ok
+}
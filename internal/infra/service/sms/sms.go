@@ -0,0 +1,47 @@
+package smsservice
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+var (
+	ErrProviderUnavailable = errors.New("sms provider unavailable")
+)
+
+// Provider sends text messages to a phone, by sms or whatsapp.
+type Provider interface {
+	Send(ctx context.Context, to string, message string) error
+}
+
+// FakeProvider keeps the messages in memory, it is used on local environments and tests.
+// Messages are never printed, they carry verification codes.
+type FakeProvider struct {
+	mu       sync.Mutex
+	messages map[string][]string
+}
+
+func NewFakeProvider() *FakeProvider {
+	return &FakeProvider{messages: map[string][]string{}}
+}
+
+func (p *FakeProvider) Send(_ context.Context, to string, message string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.messages[to] = append(p.messages[to], message)
+	return nil
+}
+
+func (p *FakeProvider) LastMessage(to string) string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	messages := p.messages[to]
+	if len(messages) == 0 {
+		return ""
+	}
+
+	return messages[len(messages)-1]
+}
@@ -0,0 +1,46 @@
+package smsservice
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTwilioProvider(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, password, _ := r.BasicAuth()
+		assert.Equal(t, "AC123", user)
+		assert.Equal(t, "secret", password)
+		assert.Equal(t, "/AC123/Messages.json", r.URL.Path)
+		assert.Nil(t, r.ParseForm())
+
+		if r.PostForm.Get("To") == "+5511000000000" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		assert.Equal(t, "+5511999999999", r.PostForm.Get("To"))
+		assert.Equal(t, "+15550000000", r.PostForm.Get("From"))
+		assert.Equal(t, "code 123456", r.PostForm.Get("Body"))
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	provider := NewTwilioProvider("AC123", "secret", "+15550000000", time.Second)
+	provider.url = server.URL + "/"
+
+	assert.Nil(t, provider.Send(context.Background(), "+5511999999999", "code 123456"))
+	assert.ErrorIs(t, provider.Send(context.Background(), "+5511000000000", "code 123456"), ErrProviderUnavailable)
+}
+
+func TestFakeProvider(t *testing.T) {
+	provider := NewFakeProvider()
+
+	assert.Nil(t, provider.Send(context.Background(), "+5511999999999", "code 123456"))
+	assert.Equal(t, "code 123456", provider.LastMessage("+5511999999999"))
+	assert.Equal(t, "", provider.LastMessage("+5511000000000"))
+}
@@ -0,0 +1,58 @@
+package smsservice
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const twilioURL = "https://api.twilio.com/2010-04-01/Accounts/"
+
+// TwilioProvider sends the messages by the twilio messages api.
+type TwilioProvider struct {
+	client     *http.Client
+	url        string
+	accountSID string
+	authToken  string
+	from       string
+}
+
+func NewTwilioProvider(accountSID string, authToken string, from string, timeout time.Duration) *TwilioProvider {
+	return &TwilioProvider{
+		client:     &http.Client{Timeout: timeout},
+		url:        twilioURL,
+		accountSID: accountSID,
+		authToken:  authToken,
+		from:       from,
+	}
+}
+
+func (p *TwilioProvider) Send(ctx context.Context, to string, message string) error {
+	form := url.Values{}
+	form.Set("To", to)
+	form.Set("From", p.from)
+	form.Set("Body", message)
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url+p.accountSID+"/Messages.json", strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+
+	request.SetBasicAuth(p.accountSID, p.authToken)
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	response, err := p.client.Do(request)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrProviderUnavailable, err.Error())
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusCreated && response.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: status %d", ErrProviderUnavailable, response.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package addressusecases
+
+import (
+	"context"
+
+	addressentity "github.com/willjrcom/sales-backend-go/internal/domain/address"
+	addressdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/address"
+	"github.com/willjrcom/sales-backend-go/internal/infra/service/cep"
+)
+
+type Service struct {
+	ra addressentity.Repository
+	cp cep.CepProvider
+}
+
+func NewService(ra addressentity.Repository, cp cep.CepProvider) *Service {
+	return &Service{ra: ra, cp: cp}
+}
+
+// GetAddressByCep returns the address of the cep with the last delivery tax charged on the neighborhood.
+func (s *Service) GetAddressByCep(ctx context.Context, cepString string) (*addressdto.CepAddressOutput, error) {
+	address, err := s.cp.Get(ctx, cepString)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Delivery tax is optional, the neighborhood may have no delivery yet
+	deliveryTax, err := s.ra.GetDeliveryTaxByNeighborhood(ctx, address.Neighborhood, address.City)
+	if err != nil {
+		deliveryTax = 0
+	}
+
+	output := &addressdto.CepAddressOutput{}
+	output.FromModel(address, deliveryTax)
+	return output, nil
+}
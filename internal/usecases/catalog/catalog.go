@@ -0,0 +1,321 @@
+package catalogusecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+	productentity "github.com/willjrcom/sales-backend-go/internal/domain/product"
+	catalogdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/catalog"
+)
+
+var (
+	ErrFormatInvalid = errors.New("format must be json or csv")
+)
+
+type Service struct {
+	rc productentity.CategoryRepository
+	rp productentity.ProductRepository
+}
+
+func NewService(rc productentity.CategoryRepository, rp productentity.ProductRepository) *Service {
+	return &Service{rc: rc, rp: rp}
+}
+
+func (s *Service) ExportCatalog(ctx context.Context) (*catalogdto.Catalog, error) {
+	categories, err := s.rc.GetAllCategories(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	products, err := s.rp.GetAllProducts(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	catalog := &catalogdto.Catalog{}
+	catalog.FromModels(categories, products)
+	return catalog, nil
+}
+
+// importState keeps the entities already imported by name and code to resolve references,
+// and the writes saved at the end of the import.
+type importState struct {
+	categories map[string]*productentity.Category
+	sizes      map[string]map[string]uuid.UUID
+	products   map[string]*productentity.Product
+	writes     *productentity.CatalogImport
+}
+
+// ImportCatalog validates the whole catalog and only writes when there are no errors and it isn't a dry run.
+// Categories are matched by name and products by code, entities missing on the file are kept.
+// Every write is saved in one transaction, product price changes are recorded on the price history.
+func (s *Service) ImportCatalog(ctx context.Context, catalog *catalogdto.Catalog, report *catalogdto.ImportReport) (*catalogdto.ImportReport, error) {
+	categories, err := s.rc.GetAllCategories(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	products, err := s.rp.GetAllProducts(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	catalog.Validate(report, categories, products)
+
+	if report.HasErrors() {
+		return report, nil
+	}
+
+	state := &importState{
+		categories: map[string]*productentity.Category{},
+		sizes:      map[string]map[string]uuid.UUID{},
+		products:   map[string]*productentity.Product{},
+		writes:     &productentity.CatalogImport{},
+	}
+
+	for i := range categories {
+		state.categories[categories[i].Name] = &categories[i]
+		state.sizes[categories[i].Name] = map[string]uuid.UUID{}
+
+		for _, size := range categories[i].Sizes {
+			state.sizes[categories[i].Name][size.Name] = size.ID
+		}
+	}
+
+	for i := range products {
+		state.products[products[i].Code] = &products[i]
+	}
+
+	for _, category := range catalog.Categories {
+		state.importCategory(report, category)
+	}
+
+	for _, category := range catalog.Categories {
+		for _, product := range category.Products {
+			state.importProduct(ctx, report, category.Name, product)
+		}
+	}
+
+	for _, category := range catalog.Categories {
+		state.importAdditionalCategories(category)
+
+		for _, product := range category.Products {
+			state.importComboSlots(product)
+		}
+	}
+
+	if report.DryRun {
+		return report, nil
+	}
+
+	if err := s.rp.ImportCatalog(ctx, state.writes); err != nil {
+		return nil, errors.New("import catalog error: " + err.Error())
+	}
+
+	report.Imported = true
+	return report, nil
+}
+
+func (state *importState) importCategory(report *catalogdto.ImportReport, input catalogdto.CatalogCategory) {
+	category, exists := state.categories[input.Name]
+	report.Categories.Add(exists)
+
+	if exists {
+		state.writes.UpdatedCategories = append(state.writes.UpdatedCategories, category)
+	} else {
+		category = productentity.NewCategory(productentity.CategoryCommonAttributes{Name: input.Name})
+		state.categories[input.Name] = category
+		state.sizes[input.Name] = map[string]uuid.UUID{}
+		state.writes.NewCategories = append(state.writes.NewCategories, category)
+	}
+
+	category.ImagePath = input.ImagePath
+	category.NeedPrint = input.NeedPrint
+	category.RemovableIngredients = input.RemovableIngredients
+	category.Schedules = input.Schedules
+
+	for _, inputSize := range input.Sizes {
+		state.importSize(report, category, inputSize)
+	}
+
+	for _, inputQuantity := range input.Quantities {
+		state.importQuantity(report, category, inputQuantity)
+	}
+
+	for _, inputRule := range input.ProcessRules {
+		state.importProcessRule(report, category, inputRule)
+	}
+}
+
+func (state *importState) importSize(report *catalogdto.ImportReport, category *productentity.Category, input catalogdto.CatalogSize) {
+	active := input.Active
+
+	for i := range category.Sizes {
+		if category.Sizes[i].Name != input.Name {
+			continue
+		}
+
+		report.Sizes.Add(true)
+		size := category.Sizes[i]
+		size.Active = &active
+		category.Sizes[i] = size
+		state.writes.UpdatedSizes = append(state.writes.UpdatedSizes, &size)
+		return
+	}
+
+	report.Sizes.Add(false)
+	size := &productentity.Size{
+		Entity:               entity.NewEntity(),
+		SizeCommonAttributes: productentity.SizeCommonAttributes{Name: input.Name, Active: &active, CategoryID: category.ID},
+	}
+
+	category.Sizes = append(category.Sizes, *size)
+	state.sizes[category.Name][size.Name] = size.ID
+	state.writes.NewSizes = append(state.writes.NewSizes, size)
+}
+
+func (state *importState) importQuantity(report *catalogdto.ImportReport, category *productentity.Category, input float64) {
+	if err := productentity.ValidateDuplicateQuantities(input, category.Quantities); err != nil {
+		report.Quantities.Add(true)
+		return
+	}
+
+	report.Quantities.Add(false)
+	quantity := &productentity.Quantity{
+		Entity:                   entity.NewEntity(),
+		QuantityCommonAttributes: productentity.QuantityCommonAttributes{Quantity: input, CategoryID: category.ID},
+	}
+
+	category.Quantities = append(category.Quantities, *quantity)
+	state.writes.NewQuantities = append(state.writes.NewQuantities, quantity)
+}
+
+func (state *importState) importProcessRule(report *catalogdto.ImportReport, category *productentity.Category, input catalogdto.CatalogProcessRule) {
+	attributes := productentity.ProcessRuleCommonAttributes{
+		Name:              input.Name,
+		Order:             input.Order,
+		IdealTime:         input.IdealTime,
+		ExperimentalError: input.ExperimentalError,
+		CategoryID:        category.ID,
+	}
+
+	for i := range category.ProcessRules {
+		if category.ProcessRules[i].Name != input.Name {
+			continue
+		}
+
+		report.ProcessRules.Add(true)
+		rule := category.ProcessRules[i]
+		rule.ProcessRuleCommonAttributes = attributes
+		category.ProcessRules[i] = rule
+		state.writes.UpdatedProcessRules = append(state.writes.UpdatedProcessRules, &rule)
+		return
+	}
+
+	report.ProcessRules.Add(false)
+	rule := productentity.NewProcessRule(attributes)
+	category.ProcessRules = append(category.ProcessRules, *rule)
+	state.writes.NewProcessRules = append(state.writes.NewProcessRules, rule)
+}
+
+func (state *importState) importProduct(ctx context.Context, report *catalogdto.ImportReport, categoryName string, input catalogdto.CatalogProduct) {
+	product, exists := state.products[input.Code]
+	report.Products.Add(exists)
+
+	if exists {
+		state.writes.UpdatedProducts = append(state.writes.UpdatedProducts, product)
+	} else {
+		product = &productentity.Product{Entity: entity.NewEntity()}
+		state.products[input.Code] = product
+		state.writes.NewProducts = append(state.writes.NewProducts, product)
+	}
+
+	oldPrice, oldCost := product.Price, product.Cost
+	sizes := state.sizes[categoryName]
+
+	product.Code = input.Code
+	product.Name = input.Name
+	product.Type = input.Type
+	product.Description = input.Description
+	product.Price = input.Price
+	product.Cost = input.Cost
+	product.IsAvailable = input.IsAvailable
+	product.Schedules = input.Schedules
+	product.CategoryID = state.categories[categoryName].ID
+	product.SizeID = sizes[input.Size]
+	product.Variants = []productentity.ProductVariant{}
+	product.ComboSlots = []productentity.ComboSlot{}
+
+	if product.Type == "" {
+		product.Type = productentity.ProductTypeSimple
+	}
+
+	for _, variant := range input.Variants {
+		product.Variants = append(product.Variants, *productentity.NewProductVariant(productentity.ProductVariantCommonAttributes{
+			Price:     variant.Price,
+			Cost:      variant.Cost,
+			SizeID:    sizes[variant.Size],
+			ProductID: product.ID,
+		}))
+	}
+
+	if input.Size == "" && len(product.Variants) != 0 {
+		product.SizeID = product.Variants[0].SizeID
+		product.Price = product.Variants[0].Price
+		product.Cost = product.Variants[0].Cost
+	}
+
+	if exists && (product.Price != oldPrice || product.Cost != oldCost) {
+		change := productentity.NewAppliedPriceChange(product, oldPrice, oldCost, companyentity.GetUserIDFromContext(ctx))
+		state.writes.PriceChanges = append(state.writes.PriceChanges, *change)
+	}
+}
+
+func (state *importState) importAdditionalCategories(input catalogdto.CatalogCategory) {
+	if len(input.AdditionalCategories) == 0 {
+		return
+	}
+
+	category := state.categories[input.Name]
+	category.AdditionalCategories = []productentity.Category{}
+
+	for _, name := range input.AdditionalCategories {
+		category.AdditionalCategories = append(category.AdditionalCategories, *state.categories[name])
+	}
+}
+
+func (state *importState) importComboSlots(input catalogdto.CatalogProduct) {
+	if len(input.ComboSlots) == 0 {
+		return
+	}
+
+	product := state.products[input.Code]
+
+	for _, inputSlot := range input.ComboSlots {
+		attributes := productentity.ComboSlotCommonAttributes{
+			Name:      inputSlot.Name,
+			Order:     inputSlot.Order,
+			ProductID: product.ID,
+		}
+
+		if inputSlot.Category != "" {
+			attributes.CategoryID = &state.categories[inputSlot.Category].ID
+		}
+
+		for _, option := range inputSlot.Options {
+			attributes.Options = append(attributes.Options, productentity.ComboSlotOption{
+				ProductID: state.products[option.ProductCode].ID,
+				Upcharge:  option.Upcharge,
+			})
+		}
+
+		product.ComboSlots = append(product.ComboSlots, *productentity.NewComboSlot(attributes))
+	}
+}
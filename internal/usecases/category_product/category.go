@@ -64,6 +64,37 @@ func (s *Service) UpdateCategory(ctx context.Context, dtoId *entitydto.IdRequest
 	return nil
 }
 
+// CloneCategory copies the category with its sizes, quantities, process rules and optionally its products.
+func (s *Service) CloneCategory(ctx context.Context, dtoId *entitydto.IdRequest, dto *categorydto.CloneCategoryInput) (uuid.UUID, error) {
+	options, err := dto.ToOptions()
+
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	category, err := s.r.GetCategoryById(ctx, dtoId.ID.String())
+
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if c, _ := s.r.GetCategoryByName(ctx, options.Name, false); c != nil {
+		return uuid.Nil, errors.New("category name already exists")
+	}
+
+	clone, err := category.Clone(options)
+
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if err = s.r.CloneCategory(ctx, clone); err != nil {
+		return uuid.Nil, err
+	}
+
+	return clone.ID, nil
+}
+
 func (s *Service) DeleteCategoryById(ctx context.Context, dto *entitydto.IdRequest) error {
 	if _, err := s.r.GetCategoryById(ctx, dto.ID.String()); err != nil {
 		return err
@@ -0,0 +1,87 @@
+package clientusecases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	addressdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/address"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+)
+
+// RegisterAddressToClient adds an address to the client, the first address of the client is the default one.
+func (s *Service) RegisterAddressToClient(ctx context.Context, dtoId *entitydto.IdRequest, dto *addressdto.RegisterAddressInput) (uuid.UUID, error) {
+	address, err := dto.ToModel()
+
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	client, err := s.rclient.GetClientById(ctx, dtoId.ID.String())
+
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	address.ObjectID = client.ID
+
+	if err := address.Validate(); err != nil {
+		return uuid.Nil, err
+	}
+
+	if err := s.raddress.RegisterAddress(ctx, address); err != nil {
+		return uuid.Nil, err
+	}
+
+	if address.IsDefault || len(client.Addresses) == 0 {
+		if err := s.rclient.SetDefaultAddress(ctx, client.ID.String(), address.ID.String()); err != nil {
+			return uuid.Nil, err
+		}
+	}
+
+	return address.ID, nil
+}
+
+func (s *Service) SetDefaultAddress(ctx context.Context, dtoId *entitydto.IdRequest, dtoAddressId *entitydto.IdRequest) error {
+	client, err := s.rclient.GetClientById(ctx, dtoId.ID.String())
+
+	if err != nil {
+		return err
+	}
+
+	if err := client.SetDefaultAddress(dtoAddressId.ID); err != nil {
+		return err
+	}
+
+	return s.rclient.SetDefaultAddress(ctx, client.ID.String(), dtoAddressId.ID.String())
+}
+
+// DeleteAddressFromClient removes the address, the next address becomes the default one when the default is removed.
+func (s *Service) DeleteAddressFromClient(ctx context.Context, dtoId *entitydto.IdRequest, dtoAddressId *entitydto.IdRequest) error {
+	client, err := s.rclient.GetClientById(ctx, dtoId.ID.String())
+
+	if err != nil {
+		return err
+	}
+
+	address, err := client.GetAddress(dtoAddressId.ID)
+
+	if err != nil {
+		return err
+	}
+
+	if err := s.raddress.DeleteAddress(ctx, address.ID.String()); err != nil {
+		return err
+	}
+
+	if !address.IsDefault {
+		return nil
+	}
+
+	for _, other := range client.Addresses {
+		if other.ID != address.ID {
+			return s.rclient.SetDefaultAddress(ctx, client.ID.String(), other.ID.String())
+		}
+	}
+
+	return nil
+}
@@ -5,7 +5,9 @@ import (
 	"errors"
 
 	"github.com/google/uuid"
+	addressentity "github.com/willjrcom/sales-backend-go/internal/domain/address"
 	cliententity "github.com/willjrcom/sales-backend-go/internal/domain/client"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
 	personentity "github.com/willjrcom/sales-backend-go/internal/domain/person"
 	clientdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/client"
 	contactdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/contact"
@@ -16,10 +18,12 @@ import (
 type Service struct {
 	rclient  cliententity.Repository
 	rcontact personentity.ContactRepository
+	raddress addressentity.Repository
+	rorder   orderentity.OrderRepository
 }
 
-func NewService(rcliente cliententity.Repository, rcontact personentity.ContactRepository) *Service {
-	return &Service{rclient: rcliente, rcontact: rcontact}
+func NewService(rcliente cliententity.Repository, rcontact personentity.ContactRepository, raddress addressentity.Repository, rorder orderentity.OrderRepository) *Service {
+	return &Service{rclient: rcliente, rcontact: rcontact, raddress: raddress, rorder: rorder}
 }
 
 func (s *Service) RegisterClient(ctx context.Context, dto *clientdto.RegisterClientInput) (uuid.UUID, error) {
@@ -0,0 +1,87 @@
+package clientusecases
+
+import (
+	"context"
+	"sort"
+
+	"github.com/google/uuid"
+	cliententity "github.com/willjrcom/sales-backend-go/internal/domain/client"
+	clientdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/client"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+)
+
+// FindDuplicateClients returns the pairs of clients probably registered twice, best matches first.
+func (s *Service) FindDuplicateClients(ctx context.Context) ([]clientdto.DuplicateClientOutput, error) {
+	clients, err := s.rclient.GetAllClients(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	clientsByID := map[uuid.UUID]*cliententity.Client{}
+	for i := range clients {
+		clientsByID[clients[i].ID] = &clients[i]
+	}
+
+	candidates := cliententity.FindDuplicates(clients, cliententity.DefaultDuplicateScore)
+
+	dtos := make([]clientdto.DuplicateClientOutput, len(candidates))
+	for i, candidate := range candidates {
+		dtos[i].FromModel(candidate, clientsByID[candidate.ClientID], clientsByID[candidate.DuplicateID])
+	}
+
+	sort.SliceStable(dtos, func(i, j int) bool {
+		return dtos[i].Score > dtos[j].Score
+	})
+
+	return dtos, nil
+}
+
+// MergeClients moves the history of the merged clients to the surviving client and deletes the merged clients.
+func (s *Service) MergeClients(ctx context.Context, dto *clientdto.MergeClientsInput) (*clientdto.ClientOutput, error) {
+	if err := dto.Validate(); err != nil {
+		return nil, err
+	}
+
+	survivor, err := s.rclient.GetClientById(ctx, dto.SurvivorID.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	merges := []cliententity.ClientMerge{}
+	seen := map[uuid.UUID]bool{}
+
+	for _, id := range dto.MergedIDs {
+		if seen[id] {
+			continue
+		}
+
+		seen[id] = true
+
+		merged, err := s.rclient.GetClientById(ctx, id.String())
+
+		if err != nil {
+			return nil, err
+		}
+
+		merge, err := cliententity.NewClientMerge(survivor, merged)
+
+		if err != nil {
+			return nil, err
+		}
+
+		survivor.FillFrom(merged)
+		merges = append(merges, *merge)
+	}
+
+	if err := s.rclient.MergeClients(ctx, survivor, merges); err != nil {
+		return nil, err
+	}
+
+	return s.GetClientById(ctx, &entitydto.IdRequest{ID: survivor.ID})
+}
+
+func (s *Service) GetAllClientMerges(ctx context.Context) ([]cliententity.ClientMerge, error) {
+	return s.rclient.GetAllClientMerges(ctx)
+}
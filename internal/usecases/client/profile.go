@@ -0,0 +1,76 @@
+package clientusecases
+
+import (
+	"context"
+	"time"
+
+	cliententity "github.com/willjrcom/sales-backend-go/internal/domain/client"
+	clientdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/client"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+)
+
+// GetClientProfile returns the client with its orders, stats and favourite items.
+func (s *Service) GetClientProfile(ctx context.Context, dto *entitydto.IdRequest) (*clientdto.ClientProfileOutput, error) {
+	client, err := s.rclient.GetClientById(ctx, dto.ID.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	orders, err := s.rorder.GetOrdersByClientId(ctx, client.ID.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	output := &clientdto.ClientProfileOutput{}
+	output.FromModel(client, orders)
+	return output, nil
+}
+
+// GetRFM scores every client with completed orders and counts the clients of each segment.
+func (s *Service) GetRFM(ctx context.Context) (*clientdto.RFMOutput, error) {
+	stats, err := s.rclient.GetAllClientStats(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	clients, err := s.rclient.GetAllClients(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	clientsByID := map[string]*cliententity.Client{}
+	for i := range clients {
+		clientsByID[clients[i].ID.String()] = &clients[i]
+	}
+
+	output := &clientdto.RFMOutput{}
+	output.FromModel(cliententity.ScoreRFM(stats, time.Now()), clientsByID)
+	return output, nil
+}
+
+// ExportSegment returns the clients of the segment.
+func (s *Service) ExportSegment(ctx context.Context, segment cliententity.Segment) (*clientdto.RFMOutput, error) {
+	if err := cliententity.ValidateSegment(segment); err != nil {
+		return nil, err
+	}
+
+	rfm, err := s.GetRFM(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	clients := []clientdto.ClientRFMOutput{}
+	for _, client := range rfm.Clients {
+		if client.Segment == segment {
+			clients = append(clients, client)
+		}
+	}
+
+	rfm.Clients = clients
+	return rfm, nil
+}
@@ -22,10 +22,11 @@ type Service struct {
 	s  schemaservice.Service
 	u  companyentity.UserRepository
 	us userusecases.Service
+	cp cnpj.CnpjProvider
 }
 
-func NewService(r companyentity.CompanyRepository, a addressentity.Repository, s schemaservice.Service, u companyentity.UserRepository, us userusecases.Service) *Service {
-	return &Service{r: r, a: a, s: s, u: u, us: us}
+func NewService(r companyentity.CompanyRepository, a addressentity.Repository, s schemaservice.Service, u companyentity.UserRepository, us userusecases.Service, cp cnpj.CnpjProvider) *Service {
+	return &Service{r: r, a: a, s: s, u: u, us: us, cp: cp}
 }
 
 func (s *Service) NewCompany(ctx context.Context, dto *companydto.CompanyInput) (id uuid.UUID, schemaName *string, err error) {
@@ -34,7 +35,14 @@ func (s *Service) NewCompany(ctx context.Context, dto *companydto.CompanyInput)
 		return uuid.Nil, nil, err
 	}
 
-	cnpjData, err := cnpj.Get(cnpjString)
+	cnpjData, err := s.cp.Get(ctx, cnpjString)
+
+	// Manual entry when the provider is down
+	if cnpj.IsUnavailable(err) {
+		if manualData, ok := dto.ManualCnpj(); ok {
+			cnpjData, err = manualData, nil
+		}
+	}
 
 	if err != nil {
 		return uuid.Nil, nil, err
@@ -47,6 +55,7 @@ func (s *Service) NewCompany(ctx context.Context, dto *companydto.CompanyInput)
 	company := companyentity.NewCompany(cnpjData)
 	company.Email = email
 	company.Contacts = contacts
+	company.Timezone = dto.Timezone
 	fmt.Println(company.SchemaName)
 	ctx = context.WithValue(ctx, schemaentity.Schema("schema"), company.SchemaName)
 
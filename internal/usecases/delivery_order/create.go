@@ -13,21 +13,33 @@ func (s *Service) CreateDeliveryOrder(ctx context.Context, dto *deliveryorderdto
 		return nil, err
 	}
 
-	orderID, err := s.os.CreateDefaultOrder(ctx)
+	// Validate client
+	client, err := s.rc.GetClientById(ctx, delivery.ClientID.String())
+	if err != nil {
+		return nil, err
+	}
 
+	// Default address of the client when none is chosen
+	address, err := client.DeliveryAddress(dto.AddressID)
 	if err != nil {
 		return nil, err
 	}
 
-	delivery.OrderID = orderID
+	// Addresses outside every delivery zone are rejected
+	quote, err := s.zs.QuoteDelivery(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	orderID, err := s.os.CreateDefaultOrder(ctx)
 
-	// Validate client
-	client, err := s.rc.GetClientById(ctx, delivery.ClientID.String())
 	if err != nil {
 		return nil, err
 	}
 
-	delivery.AddressID = client.Address.ID
+	delivery.OrderID = orderID
+	delivery.AddressID = address.ID
+	delivery.ApplyQuote(quote)
 
 	if err = s.rdo.CreateDeliveryOrder(ctx, delivery); err != nil {
 		return nil, err
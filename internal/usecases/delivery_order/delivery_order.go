@@ -2,6 +2,7 @@ package deliveryorderusecases
 
 import (
 	"context"
+	"io"
 
 	addressentity "github.com/willjrcom/sales-backend-go/internal/domain/address"
 	cliententity "github.com/willjrcom/sales-backend-go/internal/domain/client"
@@ -9,6 +10,8 @@ import (
 	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
 	deliveryorderdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/delivery"
 	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	"github.com/willjrcom/sales-backend-go/internal/infra/service/s3"
+	deliveryzoneusecases "github.com/willjrcom/sales-backend-go/internal/usecases/delivery_zone"
 	orderusecases "github.com/willjrcom/sales-backend-go/internal/usecases/order"
 )
 
@@ -17,6 +20,8 @@ type IService interface {
 	IGetService
 	IUpdateService
 	IStatusService
+	IDispatchService
+	IDriverService
 }
 
 type ICreateService interface {
@@ -26,7 +31,7 @@ type ICreateService interface {
 type IGetService interface {
 	GetDeliveryById(ctx context.Context, dto *entitydto.IdRequest) (*orderentity.DeliveryOrder, error)
 	GetAllDeliveries(ctx context.Context) ([]orderentity.DeliveryOrder, error)
-	GetDeliveryOrderByStatus(ctx context.Context) (deliveries []orderentity.DeliveryOrder, err error)
+	GetDeliveryOrderByStatus(ctx context.Context, dto *deliveryorderdto.DeliveryOrderByStatusInput) ([]orderentity.DeliveryOrder, error)
 	GetDeliveryOrderByClientId(ctx context.Context, dto *entitydto.IdRequest) ([]orderentity.DeliveryOrder, error)
 	GetDeliveryOrderByDriverId(ctx context.Context, dto *entitydto.IdRequest) ([]orderentity.DeliveryOrder, error)
 }
@@ -34,8 +39,26 @@ type IGetService interface {
 type IUpdateService interface {
 	LaunchDeliveryOrder(ctx context.Context, dtoID *entitydto.IdRequest, dtoDriver *deliveryorderdto.UpdateDriverOrder) (err error)
 	FinishDeliveryOrder(ctx context.Context, dtoID *entitydto.IdRequest) (err error)
-	UpdateDeliveryAddress(ctx context.Context, dtoID *entitydto.IdRequest) (err error)
+	UpdateDeliveryAddress(ctx context.Context, dtoID *entitydto.IdRequest, dto *deliveryorderdto.UpdateDeliveryOrder) (err error)
 	UpdateDeliveryDriver(ctx context.Context, dto *entitydto.IdRequest, deliveryOrder *deliveryorderdto.UpdateDriverOrder) (err error)
+	ReadyDeliveryOrder(ctx context.Context, dtoID *entitydto.IdRequest) error
+	FailDeliveryOrder(ctx context.Context, dtoID *entitydto.IdRequest, dto *deliveryorderdto.DeliveryReasonInput) error
+	ReturnDeliveryOrder(ctx context.Context, dtoID *entitydto.IdRequest) error
+	CancelDeliveryOrder(ctx context.Context, dtoID *entitydto.IdRequest, dto *deliveryorderdto.DeliveryReasonInput) error
+}
+
+type IDispatchService interface {
+	GetDispatchBoard(ctx context.Context) (*deliveryorderdto.DispatchBoardOutput, error)
+	LaunchDeliveryBatch(ctx context.Context, dto *deliveryorderdto.LaunchDeliveryBatchInput) (*deliveryorderdto.DeliveryBatchIDOutput, error)
+}
+
+type IDriverService interface {
+	GetMyDeliveries(ctx context.Context) ([]orderentity.DeliveryOrder, error)
+	AcceptMyDelivery(ctx context.Context, dtoID *entitydto.IdRequest) error
+	PickupMyDelivery(ctx context.Context, dtoID *entitydto.IdRequest) error
+	UploadMyDeliveryPhoto(ctx context.Context, dtoID *entitydto.IdRequest, photo io.Reader) (string, error)
+	FinishMyDelivery(ctx context.Context, dtoID *entitydto.IdRequest, dto *deliveryorderdto.DeliverOrderInput) error
+	FailMyDelivery(ctx context.Context, dtoID *entitydto.IdRequest, dto *deliveryorderdto.DeliveryReasonInput) error
 }
 
 type IStatusService interface {
@@ -49,8 +72,10 @@ type Service struct {
 	ro  orderentity.OrderRepository
 	re  employeeentity.Repository
 	os  *orderusecases.Service
+	zs  *deliveryzoneusecases.Service
+	st  s3.Storage
 }
 
-func NewService(rdo orderentity.DeliveryOrderRepository, ra addressentity.Repository, rc cliententity.Repository, ro orderentity.OrderRepository, re employeeentity.Repository, os *orderusecases.Service) IService {
-	return &Service{rdo: rdo, ra: ra, rc: rc, ro: ro, re: re, os: os}
+func NewService(rdo orderentity.DeliveryOrderRepository, ra addressentity.Repository, rc cliententity.Repository, ro orderentity.OrderRepository, re employeeentity.Repository, os *orderusecases.Service, zs *deliveryzoneusecases.Service, st s3.Storage) IService {
+	return &Service{rdo: rdo, ra: ra, rc: rc, ro: ro, re: re, os: os, zs: zs, st: st}
 }
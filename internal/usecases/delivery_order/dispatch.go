@@ -0,0 +1,63 @@
+package deliveryorderusecases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+	deliveryorderdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/delivery"
+)
+
+// GetDispatchBoard groups the deliveries waiting for a driver in batches with the suggested order of the stops.
+func (s *Service) GetDispatchBoard(ctx context.Context) (*deliveryorderdto.DispatchBoardOutput, error) {
+	deliveries, err := s.rdo.GetDeliveriesToDispatch(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	store, err := s.zs.StoreLocation(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &deliveryorderdto.DispatchBoardOutput{
+		Batches: orderentity.PlanBatches(deliveries, store, orderentity.DefaultBatchSize),
+		Total:   len(deliveries),
+	}, nil
+}
+
+// LaunchDeliveryBatch launches every delivery with the driver at once, keeping the order of the stops.
+func (s *Service) LaunchDeliveryBatch(ctx context.Context, dto *deliveryorderdto.LaunchDeliveryBatchInput) (*deliveryorderdto.DeliveryBatchIDOutput, error) {
+	if err := dto.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateDriver(ctx, *dto.DriverID); err != nil {
+		return nil, err
+	}
+
+	deliveries := []orderentity.DeliveryOrder{}
+	batchID := uuid.New()
+
+	for i, id := range dto.DeliveryIDs {
+		delivery, err := s.rdo.GetDeliveryById(ctx, id.String())
+
+		if err != nil {
+			return nil, err
+		}
+
+		if err := delivery.LaunchInBatch(*dto.DriverID, batchID, i+1); err != nil {
+			return nil, err
+		}
+
+		deliveries = append(deliveries, *delivery)
+	}
+
+	if err := s.rdo.UpdateDeliveryOrders(ctx, deliveries); err != nil {
+		return nil, err
+	}
+
+	return &deliveryorderdto.DeliveryBatchIDOutput{BatchID: batchID}, nil
+}
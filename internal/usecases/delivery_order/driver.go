@@ -0,0 +1,185 @@
+package deliveryorderusecases
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"io"
+
+	"github.com/google/uuid"
+
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+	employeeentity "github.com/willjrcom/sales-backend-go/internal/domain/employee"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+	deliveryorderdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/delivery"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+)
+
+var (
+	ErrDriverNotLogged   = errors.New("logged user is not an employee")
+	ErrDriverNotFound    = errors.New("driver is not an employee")
+	ErrDriverWithoutUser = errors.New("driver must have a user to receive the deliveries")
+)
+
+// GetMyDeliveries returns the assigned and launched deliveries of the logged driver.
+func (s *Service) GetMyDeliveries(ctx context.Context) ([]orderentity.DeliveryOrder, error) {
+	driver, err := s.getDriver(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return s.rdo.GetDeliveriesByDriverId(ctx, driver.ID.String())
+}
+
+// AcceptMyDelivery confirms the logged driver will take the delivery.
+func (s *Service) AcceptMyDelivery(ctx context.Context, dtoID *entitydto.IdRequest) error {
+	driver, delivery, err := s.getDriverDelivery(ctx, dtoID)
+
+	if err != nil {
+		return err
+	}
+
+	if err := delivery.AcceptDelivery(driver.ID); err != nil {
+		return err
+	}
+
+	return s.rdo.UpdateDeliveryOrder(ctx, delivery)
+}
+
+// PickupMyDelivery confirms the logged driver took the order at the store.
+func (s *Service) PickupMyDelivery(ctx context.Context, dtoID *entitydto.IdRequest) error {
+	driver, delivery, err := s.getDriverDelivery(ctx, dtoID)
+
+	if err != nil {
+		return err
+	}
+
+	if err := delivery.PickupDelivery(driver.ID); err != nil {
+		return err
+	}
+
+	return s.rdo.UpdateDeliveryOrder(ctx, delivery)
+}
+
+// UploadMyDeliveryPhoto keeps the photo of the delivery on the storage as proof.
+func (s *Service) UploadMyDeliveryPhoto(ctx context.Context, dtoID *entitydto.IdRequest, photo io.Reader) (string, error) {
+	_, delivery, err := s.getDriverDelivery(ctx, dtoID)
+
+	if err != nil {
+		return "", err
+	}
+
+	if delivery.DeliveredAt == nil && delivery.LaunchedAt == nil {
+		return "", orderentity.ErrDeliveryNotLaunched
+	}
+
+	key, err := s.st.Upload(ctx, photo)
+
+	if err != nil {
+		return "", err
+	}
+
+	delivery.PhotoKey = key
+
+	if err := s.rdo.UpdateDeliveryOrder(ctx, delivery); err != nil {
+		return "", err
+	}
+
+	return key, nil
+}
+
+// FinishMyDelivery marks the delivery of the logged driver as delivered,
+// the payment collected by the driver is added to the order.
+func (s *Service) FinishMyDelivery(ctx context.Context, dtoID *entitydto.IdRequest, dto *deliveryorderdto.DeliverOrderInput) error {
+	driver, delivery, err := s.getDriverDelivery(ctx, dtoID)
+
+	if err != nil {
+		return err
+	}
+
+	if err := delivery.DeliverWithProof(driver.ID, dto.ToModel()); err != nil {
+		return err
+	}
+
+	var order *orderentity.Order
+	var payment *orderentity.PaymentOrder
+
+	if dto.Payment != nil {
+		dto.Payment.DriverID = &driver.ID
+
+		if order, payment, err = s.os.NewPayment(ctx, &entitydto.IdRequest{ID: delivery.OrderID}, dto.Payment); err != nil {
+			return err
+		}
+	}
+
+	return s.rdo.DeliverDeliveryOrder(ctx, delivery, order, payment)
+}
+
+// FailMyDelivery records the logged driver could not hand the delivery,
+// the payments collected by the driver are refunded.
+func (s *Service) FailMyDelivery(ctx context.Context, dtoID *entitydto.IdRequest, dto *deliveryorderdto.DeliveryReasonInput) error {
+	_, delivery, err := s.getDriverDelivery(ctx, dtoID)
+
+	if err != nil {
+		return err
+	}
+
+	return s.failDelivery(ctx, delivery, dto.Reason)
+}
+
+// getDriver returns the employee of the logged user.
+func (s *Service) getDriver(ctx context.Context) (*employeeentity.Employee, error) {
+	userID := companyentity.GetUserIDFromContext(ctx)
+
+	if userID == nil {
+		return nil, ErrDriverNotLogged
+	}
+
+	driver, err := s.re.GetEmployeeByUserId(ctx, userID.String())
+
+	if err != nil {
+		return nil, ErrDriverNotLogged
+	}
+
+	return driver, nil
+}
+
+// validateDriver checks the driver is an employee with a user, the deliveries are followed in the driver app.
+func (s *Service) validateDriver(ctx context.Context, driverID uuid.UUID) error {
+	driver, err := s.re.GetEmployeeById(ctx, driverID.String())
+
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && driver == nil) {
+		return ErrDriverNotFound
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if driver.UserID == nil {
+		return ErrDriverWithoutUser
+	}
+
+	return nil
+}
+
+func (s *Service) getDriverDelivery(ctx context.Context, dtoID *entitydto.IdRequest) (*employeeentity.Employee, *orderentity.DeliveryOrder, error) {
+	driver, err := s.getDriver(ctx)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	delivery, err := s.rdo.GetDeliveryById(ctx, dtoID.ID.String())
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := delivery.CheckDriver(driver.ID); err != nil {
+		return nil, nil, err
+	}
+
+	return driver, delivery, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+	deliveryorderdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/delivery"
 	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
 )
 
@@ -27,14 +28,27 @@ func (s *Service) GetAllDeliveryOrderStatus(ctx context.Context) (deliveries []o
 	return orderentity.GetAllDeliveryStatus()
 }
 
-func (s Service) GetDeliveryOrderByClientId(ctx context.Context, dto *entitydto.IdRequest) ([]orderentity.DeliveryOrder, error) {
-	return nil, nil
+func (s *Service) GetDeliveryOrderByStatus(ctx context.Context, dto *deliveryorderdto.DeliveryOrderByStatusInput) ([]orderentity.DeliveryOrder, error) {
+	if err := dto.Validate(); err != nil {
+		return nil, err
+	}
+
+	return s.rdo.GetDeliveriesByStatus(ctx, dto.Status)
 }
 
-func (s *Service) GetDeliveryOrderByDriverId(ctx context.Context, dto *entitydto.IdRequest) ([]orderentity.DeliveryOrder, error) {
-	return nil, nil
+func (s *Service) GetDeliveryOrderByClientId(ctx context.Context, dto *entitydto.IdRequest) ([]orderentity.DeliveryOrder, error) {
+	if deliveries, err := s.rdo.GetDeliveriesByClientId(ctx, dto.ID.String()); err != nil {
+		return nil, err
+	} else {
+		return deliveries, nil
+	}
 }
 
-func (s *Service) GetDeliveryOrderByStatus(ctx context.Context) (deliveries []orderentity.DeliveryOrder, err error) {
-	return nil, nil
+// GetDeliveryOrderByDriverId returns the current run of the driver, assigned and launched deliveries by stop.
+func (s *Service) GetDeliveryOrderByDriverId(ctx context.Context, dto *entitydto.IdRequest) ([]orderentity.DeliveryOrder, error) {
+	if deliveries, err := s.rdo.GetDeliveriesByDriverId(ctx, dto.ID.String()); err != nil {
+		return nil, err
+	} else {
+		return deliveries, nil
+	}
 }
@@ -0,0 +1,107 @@
+package deliveryorderusecases
+
+import (
+	"context"
+
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+	deliveryorderdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/delivery"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+)
+
+// ReadyDeliveryOrder marks the order as ready at the store, waiting for a driver.
+func (s *Service) ReadyDeliveryOrder(ctx context.Context, dtoID *entitydto.IdRequest) error {
+	deliveryOrder, err := s.rdo.GetDeliveryById(ctx, dtoID.ID.String())
+
+	if err != nil {
+		return err
+	}
+
+	if err := deliveryOrder.ReadyDelivery(); err != nil {
+		return err
+	}
+
+	return s.rdo.UpdateDeliveryOrder(ctx, deliveryOrder)
+}
+
+// FailDeliveryOrder records the delivery could not be handed,
+// the payments collected by the driver are refunded.
+func (s *Service) FailDeliveryOrder(ctx context.Context, dtoID *entitydto.IdRequest, dto *deliveryorderdto.DeliveryReasonInput) error {
+	deliveryOrder, err := s.rdo.GetDeliveryById(ctx, dtoID.ID.String())
+
+	if err != nil {
+		return err
+	}
+
+	return s.failDelivery(ctx, deliveryOrder, dto.Reason)
+}
+
+// ReturnDeliveryOrder records the order of a failed delivery is back at the store.
+func (s *Service) ReturnDeliveryOrder(ctx context.Context, dtoID *entitydto.IdRequest) error {
+	deliveryOrder, err := s.rdo.GetDeliveryById(ctx, dtoID.ID.String())
+
+	if err != nil {
+		return err
+	}
+
+	if err := deliveryOrder.ReturnDelivery(); err != nil {
+		return err
+	}
+
+	return s.rdo.UpdateDeliveryOrder(ctx, deliveryOrder)
+}
+
+// CancelDeliveryOrder cancels the delivery and its order, every payment of the order is refunded.
+func (s *Service) CancelDeliveryOrder(ctx context.Context, dtoID *entitydto.IdRequest, dto *deliveryorderdto.DeliveryReasonInput) error {
+	deliveryOrder, err := s.rdo.GetDeliveryById(ctx, dtoID.ID.String())
+
+	if err != nil {
+		return err
+	}
+
+	order, err := s.ro.GetOrderById(ctx, deliveryOrder.OrderID.String())
+
+	if err != nil {
+		return err
+	}
+
+	// the order is canceled first, nothing is saved when it can not be canceled
+	cancelOrder := order.Status != orderentity.OrderStatusCanceled
+	if cancelOrder {
+		if err := order.CancelOrder(); err != nil {
+			return err
+		}
+	}
+
+	if err := deliveryOrder.CancelDelivery(dto.Reason); err != nil {
+		return err
+	}
+
+	refunds := order.RefundPayments(nil)
+
+	if err := s.rdo.UpdateDeliveryWithRefunds(ctx, deliveryOrder, order, refunds); err != nil {
+		return err
+	}
+
+	if cancelOrder {
+		s.os.ReverseCanceledPoints(ctx, order)
+	}
+
+	return nil
+}
+
+// failDelivery records the failure with the refund of the payments collected by the driver.
+func (s *Service) failDelivery(ctx context.Context, deliveryOrder *orderentity.DeliveryOrder, reason string) error {
+	if err := deliveryOrder.FailDelivery(reason); err != nil {
+		return err
+	}
+
+	order, err := s.ro.GetOrderById(ctx, deliveryOrder.OrderID.String())
+
+	if err != nil {
+		return err
+	}
+
+	refunds := order.RefundPayments(deliveryOrder.DriverID)
+
+	return s.rdo.UpdateDeliveryWithRefunds(ctx, deliveryOrder, order, refunds)
+}
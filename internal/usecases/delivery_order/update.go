@@ -24,11 +24,13 @@ func (s *Service) LaunchDeliveryOrder(ctx context.Context, dtoID *entitydto.IdRe
 		return err
 	}
 
-	if _, err = s.re.GetEmployeeById(ctx, deliveryOrder.DriverID.String()); err != nil {
+	if err = s.validateDriver(ctx, *deliveryOrder.DriverID); err != nil {
 		return err
 	}
 
-	deliveryOrder.LaunchDelivery(*deliveryOrder.DriverID)
+	if err = deliveryOrder.LaunchDelivery(*deliveryOrder.DriverID); err != nil {
+		return err
+	}
 
 	if err = s.rdo.UpdateDeliveryOrder(ctx, deliveryOrder); err != nil {
 		return err
@@ -44,7 +46,9 @@ func (s *Service) FinishDeliveryOrder(ctx context.Context, dtoID *entitydto.IdRe
 		return err
 	}
 
-	deliveryOrder.FinishDelivery()
+	if err = deliveryOrder.FinishDelivery(); err != nil {
+		return err
+	}
 
 	if err = s.rdo.UpdateDeliveryOrder(ctx, deliveryOrder); err != nil {
 		return err
@@ -53,7 +57,7 @@ func (s *Service) FinishDeliveryOrder(ctx context.Context, dtoID *entitydto.IdRe
 	return nil
 }
 
-func (s *Service) UpdateDeliveryAddress(ctx context.Context, dtoID *entitydto.IdRequest) error {
+func (s *Service) UpdateDeliveryAddress(ctx context.Context, dtoID *entitydto.IdRequest, dto *deliveryorderdto.UpdateDeliveryOrder) error {
 	deliveryOrder, err := s.rdo.GetDeliveryById(ctx, dtoID.ID.String())
 
 	if err != nil {
@@ -68,7 +72,27 @@ func (s *Service) UpdateDeliveryAddress(ctx context.Context, dtoID *entitydto.Id
 		return ErrOrderLaunched
 	}
 
-	deliveryOrder.AddressID = deliveryOrder.Client.Address.ID
+	if dto.AddressID == nil {
+		return deliveryorderdto.ErrInvalidAddressID
+	}
+
+	address, err := s.ra.GetAddressById(ctx, dto.AddressID.String())
+
+	if err != nil {
+		return err
+	}
+
+	quote, err := s.zs.QuoteDelivery(ctx, address)
+
+	if err != nil {
+		return err
+	}
+
+	if err := dto.UpdateModel(deliveryOrder, address); err != nil {
+		return err
+	}
+
+	deliveryOrder.ApplyQuote(quote)
 
 	if err := s.rdo.UpdateDeliveryOrder(ctx, deliveryOrder); err != nil {
 		return err
@@ -88,11 +112,11 @@ func (s *Service) UpdateDeliveryDriver(ctx context.Context, dtoID *entitydto.IdR
 		return ErrOrderDelivered
 	}
 
-	if _, err = s.re.GetEmployeeById(ctx, dto.DriverID.String()); err != nil {
+	if err := dto.UpdateModel(deliveryOrder); err != nil {
 		return err
 	}
 
-	if err := dto.UpdateModel(deliveryOrder); err != nil {
+	if err = s.validateDriver(ctx, *deliveryOrder.DriverID); err != nil {
 		return err
 	}
 
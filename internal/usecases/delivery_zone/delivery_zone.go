@@ -0,0 +1,173 @@
+package deliveryzoneusecases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	addressentity "github.com/willjrcom/sales-backend-go/internal/domain/address"
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+	deliveryzoneentity "github.com/willjrcom/sales-backend-go/internal/domain/delivery_zone"
+	deliveryzonedto "github.com/willjrcom/sales-backend-go/internal/infra/dto/delivery_zone"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+)
+
+type Service struct {
+	r  deliveryzoneentity.Repository
+	ra addressentity.Repository
+	rc companyentity.CompanyRepository
+}
+
+func NewService(r deliveryzoneentity.Repository, ra addressentity.Repository, rc companyentity.CompanyRepository) *Service {
+	return &Service{r: r, ra: ra, rc: rc}
+}
+
+func (s *Service) RegisterDeliveryZone(ctx context.Context, dto *deliveryzonedto.RegisterDeliveryZoneInput) (uuid.UUID, error) {
+	zone, err := dto.ToModel()
+
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if err := s.validateStoreLocation(ctx, zone); err != nil {
+		return uuid.Nil, err
+	}
+
+	if err := s.r.RegisterDeliveryZone(ctx, zone); err != nil {
+		return uuid.Nil, err
+	}
+
+	return zone.ID, nil
+}
+
+func (s *Service) UpdateDeliveryZone(ctx context.Context, dtoId *entitydto.IdRequest, dto *deliveryzonedto.UpdateDeliveryZoneInput) error {
+	zone, err := s.r.GetDeliveryZoneById(ctx, dtoId.ID.String())
+
+	if err != nil {
+		return err
+	}
+
+	if err := dto.UpdateModel(zone); err != nil {
+		return err
+	}
+
+	if err := s.validateStoreLocation(ctx, zone); err != nil {
+		return err
+	}
+
+	return s.r.UpdateDeliveryZone(ctx, zone)
+}
+
+func (s *Service) DeleteDeliveryZone(ctx context.Context, dtoId *entitydto.IdRequest) error {
+	if _, err := s.r.GetDeliveryZoneById(ctx, dtoId.ID.String()); err != nil {
+		return err
+	}
+
+	return s.r.DeleteDeliveryZone(ctx, dtoId.ID.String())
+}
+
+func (s *Service) GetDeliveryZoneById(ctx context.Context, dtoId *entitydto.IdRequest) (*deliveryzonedto.DeliveryZoneOutput, error) {
+	zone, err := s.r.GetDeliveryZoneById(ctx, dtoId.ID.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	output := &deliveryzonedto.DeliveryZoneOutput{}
+	output.FromModel(zone)
+	return output, nil
+}
+
+func (s *Service) GetAllDeliveryZones(ctx context.Context) ([]deliveryzonedto.DeliveryZoneOutput, error) {
+	zones, err := s.r.GetAllDeliveryZones(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := []deliveryzonedto.DeliveryZoneOutput{}
+	for i := range zones {
+		output := deliveryzonedto.DeliveryZoneOutput{}
+		output.FromModel(&zones[i])
+		outputs = append(outputs, output)
+	}
+
+	return outputs, nil
+}
+
+// GetQuoteByAddressId returns the fee of a delivery to the address.
+func (s *Service) GetQuoteByAddressId(ctx context.Context, dtoId *entitydto.IdRequest) (*deliveryzoneentity.DeliveryQuote, error) {
+	address, err := s.ra.GetAddressById(ctx, dtoId.ID.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return s.QuoteDelivery(ctx, address)
+}
+
+// QuoteDelivery finds the zone of the address, stores without active zones keep the delivery tax of the address.
+func (s *Service) QuoteDelivery(ctx context.Context, address *addressentity.Address) (*deliveryzoneentity.DeliveryQuote, error) {
+	zones, err := s.r.GetAllDeliveryZones(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !hasActiveZone(zones) {
+		return &deliveryzoneentity.DeliveryQuote{Fee: address.DeliveryTax}, nil
+	}
+
+	store, err := s.StoreLocation(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	zone, err := deliveryzoneentity.FindZone(zones, address, store)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return zone.Quote(), nil
+}
+
+// StoreLocation returns the coordinates of the address of the company, nil when they are not filled.
+func (s *Service) StoreLocation(ctx context.Context) (*deliveryzoneentity.Point, error) {
+	company, err := s.rc.GetCompany(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return deliveryzoneentity.PointOf(company.Address), nil
+}
+
+// validateStoreLocation rejects active radius zones while the company address has no coordinates.
+func (s *Service) validateStoreLocation(ctx context.Context, zone *deliveryzoneentity.DeliveryZone) error {
+	if !zone.RequiresStoreLocation() {
+		return nil
+	}
+
+	store, err := s.StoreLocation(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	if store == nil {
+		return deliveryzoneentity.ErrStoreLocationRequired
+	}
+
+	return nil
+}
+
+func hasActiveZone(zones []deliveryzoneentity.DeliveryZone) bool {
+	for _, zone := range zones {
+		if zone.IsActive {
+			return true
+		}
+	}
+
+	return false
+}
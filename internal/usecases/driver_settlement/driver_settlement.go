@@ -0,0 +1,151 @@
+package driversettlementusecases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	driversettlemententity "github.com/willjrcom/sales-backend-go/internal/domain/driver_settlement"
+	employeeentity "github.com/willjrcom/sales-backend-go/internal/domain/employee"
+	shiftentity "github.com/willjrcom/sales-backend-go/internal/domain/shift"
+	driversettlementdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/driver_settlement"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+)
+
+type Service struct {
+	r  driversettlemententity.Repository
+	re employeeentity.Repository
+	rs shiftentity.ShiftRepository
+}
+
+func NewService(r driversettlemententity.Repository, re employeeentity.Repository, rs shiftentity.ShiftRepository) *Service {
+	return &Service{r: r, re: re, rs: rs}
+}
+
+// RegisterDriverSettlement opens the report with the deliveries of the driver not settled yet.
+func (s *Service) RegisterDriverSettlement(ctx context.Context, dto *driversettlementdto.RegisterDriverSettlementInput) (uuid.UUID, error) {
+	settlement, err := dto.ToModel()
+
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if _, err := s.re.GetEmployeeById(ctx, settlement.DriverID.String()); err != nil {
+		return uuid.Nil, err
+	}
+
+	if settlement.ShiftID != nil {
+		if _, err := s.rs.GetShiftByID(ctx, settlement.ShiftID.String()); err != nil {
+			return uuid.Nil, err
+		}
+	}
+
+	if err := s.calculate(ctx, settlement); err != nil {
+		return uuid.Nil, err
+	}
+
+	if err := s.r.RegisterDriverSettlement(ctx, settlement); err != nil {
+		return uuid.Nil, err
+	}
+
+	return settlement.ID, nil
+}
+
+// RefreshDriverSettlement calculates the open report again, with the deliveries finished since it was opened.
+func (s *Service) RefreshDriverSettlement(ctx context.Context, dtoId *entitydto.IdRequest) (*driversettlementdto.DriverSettlementOutput, error) {
+	settlement, err := s.r.GetDriverSettlementById(ctx, dtoId.ID.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.calculate(ctx, settlement); err != nil {
+		return nil, err
+	}
+
+	if err := s.r.UpdateDriverSettlement(ctx, settlement); err != nil {
+		return nil, err
+	}
+
+	output := &driversettlementdto.DriverSettlementOutput{}
+	output.FromModel(settlement)
+	return output, nil
+}
+
+// CloseDriverSettlement calculates the report a last time and settles it, it can not change anymore.
+func (s *Service) CloseDriverSettlement(ctx context.Context, dtoId *entitydto.IdRequest) (*driversettlementdto.DriverSettlementOutput, error) {
+	settlement, err := s.r.GetDriverSettlementById(ctx, dtoId.ID.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.calculate(ctx, settlement); err != nil {
+		return nil, err
+	}
+
+	if err := settlement.Close(); err != nil {
+		return nil, err
+	}
+
+	if err := s.r.CloseDriverSettlement(ctx, settlement); err != nil {
+		return nil, err
+	}
+
+	output := &driversettlementdto.DriverSettlementOutput{}
+	output.FromModel(settlement)
+	return output, nil
+}
+
+func (s *Service) GetDriverSettlementById(ctx context.Context, dtoId *entitydto.IdRequest) (*driversettlementdto.DriverSettlementOutput, error) {
+	settlement, err := s.r.GetDriverSettlementById(ctx, dtoId.ID.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	output := &driversettlementdto.DriverSettlementOutput{}
+	output.FromModel(settlement)
+	return output, nil
+}
+
+func (s *Service) GetAllDriverSettlements(ctx context.Context) ([]driversettlementdto.DriverSettlementOutput, error) {
+	settlements, err := s.r.GetAllDriverSettlements(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := []driversettlementdto.DriverSettlementOutput{}
+	for i := range settlements {
+		output := driversettlementdto.DriverSettlementOutput{}
+		output.FromModel(&settlements[i])
+		outputs = append(outputs, output)
+	}
+
+	return outputs, nil
+}
+
+func (s *Service) calculate(ctx context.Context, settlement *driversettlemententity.DriverSettlement) error {
+	if settlement.Status == driversettlemententity.SettlementStatusSettled {
+		return driversettlemententity.ErrSettlementClosed
+	}
+
+	deliveries, err := s.r.GetDeliveriesToSettle(ctx, &settlement.SettlementPeriod)
+
+	if err != nil {
+		return err
+	}
+
+	orderIDs := []uuid.UUID{}
+	for _, delivery := range deliveries {
+		orderIDs = append(orderIDs, delivery.OrderID)
+	}
+
+	payments, err := s.r.GetPaymentsCollected(ctx, settlement.DriverID, orderIDs)
+
+	if err != nil {
+		return err
+	}
+
+	return settlement.Calculate(deliveries, payments)
+}
@@ -0,0 +1,154 @@
+package etausecases
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+	productentity "github.com/willjrcom/sales-backend-go/internal/domain/product"
+)
+
+// Service predicts the ready and delivered time of the orders from the history of the store.
+// The history of each schema is kept for the ttl, the listings and the tracking poll the orders often.
+type Service struct {
+	mu      sync.Mutex
+	re      orderentity.EtaRepository
+	rc      productentity.CategoryRepository
+	ttl     time.Duration
+	entries map[string]*historyEntry
+	now     func() time.Time
+}
+
+// historyEntry is the history of a schema, the ideal times are filled as the categories are found.
+type historyEntry struct {
+	history   orderentity.EtaHistory
+	expiresAt time.Time
+}
+
+func NewService(re orderentity.EtaRepository, rc productentity.CategoryRepository, ttl time.Duration) *Service {
+	return &Service{
+		re:      re,
+		rc:      rc,
+		ttl:     ttl,
+		entries: map[string]*historyEntry{},
+		now:     time.Now,
+	}
+}
+
+// EstimateOrders sets the eta of the orders, without history the ideal time of the process rules is used.
+func (s *Service) EstimateOrders(ctx context.Context, orders ...*orderentity.Order) {
+	now := s.now().UTC()
+	history := s.getHistory(ctx, orders, now)
+
+	for _, order := range orders {
+		order.Eta = order.Estimate(history, now)
+	}
+}
+
+// getHistory never fails, the part of the history not found is left empty and is not cached.
+func (s *Service) getHistory(ctx context.Context, orders []*orderentity.Order, now time.Time) *orderentity.EtaHistory {
+	schema, err := database.GetSchema(ctx)
+
+	if err != nil {
+		history, _ := s.loadHistory(ctx, now)
+		history.IdealTimes = s.idealTimes(ctx, orders, map[uuid.UUID]time.Duration{})
+		return history
+	}
+
+	s.mu.Lock()
+	entry, ok := s.entries[schema]
+	s.mu.Unlock()
+
+	if !ok || !now.Before(entry.expiresAt) {
+		history, complete := s.loadHistory(ctx, now)
+		history.IdealTimes = map[uuid.UUID]time.Duration{}
+		entry = &historyEntry{history: *history, expiresAt: now.Add(s.ttl)}
+
+		if complete {
+			s.mu.Lock()
+			s.entries[schema] = entry
+			s.mu.Unlock()
+		}
+	}
+
+	s.mu.Lock()
+	cached := make(map[uuid.UUID]time.Duration, len(entry.history.IdealTimes))
+	for categoryID, idealTime := range entry.history.IdealTimes {
+		cached[categoryID] = idealTime
+	}
+	s.mu.Unlock()
+
+	idealTimes := s.idealTimes(ctx, orders, cached)
+
+	s.mu.Lock()
+	for categoryID, idealTime := range idealTimes {
+		entry.history.IdealTimes[categoryID] = idealTime
+	}
+	s.mu.Unlock()
+
+	history := entry.history
+	history.IdealTimes = idealTimes
+	return &history
+}
+
+// loadHistory reads the past orders and the kitchen queue, complete is false when a part was not found.
+func (s *Service) loadHistory(ctx context.Context, now time.Time) (history *orderentity.EtaHistory, complete bool) {
+	since := now.Add(-orderentity.EtaHistoryWindow)
+	history = &orderentity.EtaHistory{}
+	complete = true
+
+	if preparations, err := s.re.GetPreparationStats(ctx, since); err == nil {
+		history.Preparations = preparations
+	} else {
+		complete = false
+	}
+
+	if travels, err := s.re.GetTravelStats(ctx, since); err == nil {
+		history.Travels = travels
+	} else {
+		complete = false
+	}
+
+	if queue, err := s.re.GetKitchenQueue(ctx); err == nil {
+		history.Queue = queue
+	} else {
+		complete = false
+	}
+
+	return history, complete
+}
+
+// idealTimes sums the ideal time of the process rules of each category of the open orders,
+// only the categories missing from the known ideal times are read.
+func (s *Service) idealTimes(ctx context.Context, orders []*orderentity.Order, idealTimes map[uuid.UUID]time.Duration) map[uuid.UUID]time.Duration {
+	for _, order := range orders {
+		if order.IsTrackingDone() {
+			continue
+		}
+
+		for _, group := range order.Groups {
+			if _, ok := idealTimes[group.CategoryID]; ok {
+				continue
+			}
+
+			category, err := s.rc.GetCategoryById(ctx, group.CategoryID.String())
+
+			// without the category the ideal time is zero, it is read again on the next estimate
+			if err != nil || category == nil {
+				continue
+			}
+
+			idealTime := time.Duration(0)
+			for _, processRule := range category.ProcessRules {
+				idealTime += processRule.IdealTime
+			}
+
+			idealTimes[group.CategoryID] = idealTime
+		}
+	}
+
+	return idealTimes
+}
@@ -0,0 +1,91 @@
+package etausecases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	groupitementity "github.com/willjrcom/sales-backend-go/internal/domain/group_item"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+	productentity "github.com/willjrcom/sales-backend-go/internal/domain/product"
+	schemaentity "github.com/willjrcom/sales-backend-go/internal/domain/schema"
+	categoryrepositorylocal "github.com/willjrcom/sales-backend-go/internal/infra/repository/local/category-product"
+)
+
+type countingEtaRepository struct {
+	calls int
+}
+
+func (r *countingEtaRepository) GetPreparationStats(ctx context.Context, since time.Time) ([]orderentity.PreparationStats, error) {
+	r.calls++
+	return []orderentity.PreparationStats{}, nil
+}
+
+func (r *countingEtaRepository) GetTravelStats(ctx context.Context, since time.Time) ([]orderentity.TravelStats, error) {
+	return []orderentity.TravelStats{}, nil
+}
+
+func (r *countingEtaRepository) GetKitchenQueue(ctx context.Context) ([]time.Time, error) {
+	return []time.Time{}, nil
+}
+
+type countingCategoryRepository struct {
+	*categoryrepositorylocal.CategoryRepositoryLocal
+	calls int
+}
+
+func (r *countingCategoryRepository) GetCategoryById(ctx context.Context, id string) (*productentity.Category, error) {
+	r.calls++
+	return r.CategoryRepositoryLocal.GetCategoryById(ctx, id)
+}
+
+func TestEstimateOrdersCache(t *testing.T) {
+	now := time.Date(2024, 5, 10, 19, 0, 0, 0, time.UTC)
+
+	category := &productentity.Category{}
+	category.ID = uuid.New()
+	category.ProcessRules = []productentity.ProcessRule{{}, {}}
+	category.ProcessRules[0].IdealTime = 10 * time.Minute
+	category.ProcessRules[1].IdealTime = 5 * time.Minute
+
+	categories := &countingCategoryRepository{CategoryRepositoryLocal: categoryrepositorylocal.NewCategoryRepositoryLocal()}
+	assert.Nil(t, categories.RegisterCategory(context.Background(), category))
+
+	history := &countingEtaRepository{}
+	service := NewService(history, categories, time.Minute)
+	service.now = func() time.Time { return now }
+
+	newOrder := func() *orderentity.Order {
+		group := groupitementity.GroupItem{}
+		group.CategoryID = category.ID
+		group.Status = groupitementity.StatusGroupStaging
+
+		order := &orderentity.Order{}
+		order.Status = orderentity.OrderStatusStaging
+		order.Groups = []groupitementity.GroupItem{group}
+		return order
+	}
+
+	ctx := context.WithValue(context.Background(), schemaentity.Schema("schema"), "loja_a")
+
+	order := newOrder()
+	service.EstimateOrders(ctx, order)
+	assert.Equal(t, now.Add(15*time.Minute), *order.Eta.ReadyAt)
+
+	// the polling of the same store reads the history once per ttl
+	service.EstimateOrders(ctx, newOrder())
+	assert.Equal(t, 1, history.calls)
+	assert.Equal(t, 1, categories.calls)
+
+	// every store has its own history
+	service.EstimateOrders(context.WithValue(context.Background(), schemaentity.Schema("schema"), "loja_b"), newOrder())
+	assert.Equal(t, 2, history.calls)
+
+	now = now.Add(time.Minute)
+	order = newOrder()
+	service.EstimateOrders(ctx, order)
+	assert.Equal(t, 3, history.calls)
+	assert.Equal(t, now.Add(15*time.Minute), *order.Eta.ReadyAt)
+}
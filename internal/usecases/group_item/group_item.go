@@ -80,7 +80,7 @@ func (s *Service) AddComplementItem(ctx context.Context, dto *entitydto.IdReques
 		return err
 	}
 
-	if groupItem.Size != product.Size.Name {
+	if err := product.ApplyVariantBySizeName(groupItem.Size); err != nil {
 		return ErrSizeMustBeTheSame
 	}
 
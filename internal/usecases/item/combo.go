@@ -0,0 +1,218 @@
+package itemusecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	groupitementity "github.com/willjrcom/sales-backend-go/internal/domain/group_item"
+	itementity "github.com/willjrcom/sales-backend-go/internal/domain/item"
+	productentity "github.com/willjrcom/sales-backend-go/internal/domain/product"
+	itemdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/item"
+)
+
+var (
+	ErrQuantityCategoryNotMatch = errors.New("category product and quantity not match")
+)
+
+type comboComponent struct {
+	input    itemdto.ComboComponentInput
+	product  *productentity.Product
+	quantity *productentity.Quantity
+	upcharge float64
+}
+
+func (s *Service) AddComboOrder(ctx context.Context, dto *itemdto.AddComboOrderInput) (*itemdto.ComboOutput, error) {
+	order, err := s.ro.GetOrderById(ctx, dto.OrderID.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	combo, err := s.rp.GetProductById(ctx, dto.ProductID.String())
+
+	if err != nil {
+		return nil, errors.New("product not found: " + err.Error())
+	}
+
+	if err = dto.ValidateSlots(combo); err != nil {
+		return nil, err
+	}
+
+	now, err := s.companyNow(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !combo.IsAvailableAt(now) {
+		return nil, productentity.ErrProductUnavailable
+	}
+
+	appliedPrice, err := s.resolvePrice(ctx, order, combo, now)
+
+	if err != nil {
+		return nil, err
+	}
+
+	components := []comboComponent{}
+	listPrices := []float64{}
+
+	for _, input := range dto.Components {
+		component, err := s.loadComboComponent(ctx, combo, input, now)
+
+		if err != nil {
+			return nil, err
+		}
+
+		components = append(components, *component)
+		listPrices = append(listPrices, component.product.Price*component.quantity.Quantity)
+	}
+
+	allocatedPrices := productentity.AllocateComboPrice(appliedPrice.Price, listPrices)
+	allocatedBasePrices := productentity.AllocateComboPrice(appliedPrice.BasePrice, listPrices)
+
+	stagingGroups, err := s.rgi.GetGroupsByOrderIDAndStatus(ctx, dto.OrderID.String(), groupitementity.StatusGroupStaging)
+
+	if err != nil {
+		return nil, errors.New("group items not found: " + err.Error())
+	}
+
+	comboID := uuid.New()
+	output := itemdto.NewComboOutput(comboID)
+	groups := &comboGroups{staging: stagingGroups}
+
+	for i, component := range components {
+		groupItem := groups.findOrCreate(dto.OrderID, component.product)
+
+		price := (allocatedPrices[i] + component.upcharge*component.quantity.Quantity) / component.quantity.Quantity
+		item := itementity.NewItem(component.product.Name, price, component.quantity.Quantity, component.product.Size.Name, itementity.StatusItemStaging)
+		item.GroupItemID = groupItem.ID
+		item.Description = component.product.Description
+		item.Observation = component.input.Observation
+		item.ProductPrice = component.product.Price
+		item.AddToCombo(comboID, combo.ID, component.input.SlotID)
+
+		basePrice := (allocatedBasePrices[i] + component.upcharge*component.quantity.Quantity) / component.quantity.Quantity
+		item.AddPriceRule(basePrice, appliedPrice.PriceListID, appliedPrice.PriceRuleID, appliedPrice.Description)
+
+		if item.Observation == "" {
+			item.Observation = dto.Observation
+		}
+
+		groupItem.Items = append(groupItem.Items, *item)
+		groups.items = append(groups.items, *item)
+		output.AddItem(item.ID, groupItem.ID)
+	}
+
+	created, updated := groups.changed()
+
+	// every component is saved or none, a failure never leaves a partial combo
+	if err = s.rgi.AddComboItems(ctx, created, updated, groups.items); err != nil {
+		return nil, errors.New("add combo error: " + err.Error())
+	}
+
+	return output, nil
+}
+
+// comboGroups are the staging groups of the order the components of the combo are added to.
+type comboGroups struct {
+	staging []groupitementity.GroupItem
+	created map[uuid.UUID]bool
+	touched map[uuid.UUID]bool
+	items   []itementity.Item
+}
+
+func (g *comboGroups) findOrCreate(orderID uuid.UUID, product *productentity.Product) *groupitementity.GroupItem {
+	if g.touched == nil {
+		g.created, g.touched = map[uuid.UUID]bool{}, map[uuid.UUID]bool{}
+	}
+
+	for i := range g.staging {
+		if g.staging[i].CategoryID == product.CategoryID && g.staging[i].Size == product.Size.Name {
+			g.touched[g.staging[i].ID] = true
+			return &g.staging[i]
+		}
+	}
+
+	groupItem := buildGroupItem(orderID, product)
+	g.staging = append(g.staging, *groupItem)
+	g.created[groupItem.ID] = true
+	g.touched[groupItem.ID] = true
+	return &g.staging[len(g.staging)-1]
+}
+
+// changed returns the new groups and the existing groups that received items, with their totals calculated.
+func (g *comboGroups) changed() (created []groupitementity.GroupItem, updated []groupitementity.GroupItem) {
+	for _, groupItem := range g.staging {
+		if !g.touched[groupItem.ID] {
+			continue
+		}
+
+		groupItem.CalculateTotalPrice()
+
+		if g.created[groupItem.ID] {
+			created = append(created, groupItem)
+		} else {
+			updated = append(updated, groupItem)
+		}
+	}
+
+	return created, updated
+}
+
+func (s *Service) loadComboComponent(ctx context.Context, combo *productentity.Product, input itemdto.ComboComponentInput, now time.Time) (*comboComponent, error) {
+	slot, err := combo.GetComboSlot(input.SlotID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	product, err := s.rp.GetProductById(ctx, input.ProductID.String())
+
+	if err != nil {
+		return nil, errors.New("product not found: " + err.Error())
+	}
+
+	if product.Category == nil {
+		return nil, ErrCategoryNotFound
+	}
+
+	if !product.IsAvailableAt(now) {
+		return nil, productentity.ErrProductUnavailable
+	}
+
+	if input.SizeID != nil {
+		if err := product.ApplyVariant(*input.SizeID); err != nil {
+			return nil, err
+		}
+	}
+
+	if product.Size == nil {
+		return nil, ErrSizeNotFound
+	}
+
+	upcharge, err := slot.Upcharge(product)
+
+	if err != nil {
+		return nil, err
+	}
+
+	quantity, err := s.rq.GetQuantityById(ctx, input.QuantityID.String())
+
+	if err != nil {
+		return nil, errors.New("quantity not found: " + err.Error())
+	}
+
+	if quantity.CategoryID != product.CategoryID {
+		return nil, ErrQuantityCategoryNotMatch
+	}
+
+	return &comboComponent{
+		input:    input,
+		product:  product,
+		quantity: quantity,
+		upcharge: upcharge,
+	}, nil
+}
@@ -3,8 +3,10 @@ package itemusecases
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/google/uuid"
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
 	groupitementity "github.com/willjrcom/sales-backend-go/internal/domain/group_item"
 	itementity "github.com/willjrcom/sales-backend-go/internal/domain/item"
 	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
@@ -19,6 +21,7 @@ var (
 	ErrSizeMustBeTheSame        = errors.New("size must be the same")
 	ErrGroupNotStaging          = errors.New("group not staging")
 	ErrItemNotStagingAndPending = errors.New("item not staging or pending")
+	ErrGroupNotFromOrder        = errors.New("group item is from another order")
 )
 
 type Service struct {
@@ -27,14 +30,18 @@ type Service struct {
 	ro  orderentity.OrderRepository
 	rp  productentity.ProductRepository
 	rq  productentity.QuantityRepository
+	rc  companyentity.CompanyRepository
+	rpl productentity.PriceListRepository
 }
 
-func NewService(ri itementity.ItemRepository, rgi groupitementity.GroupItemRepository, ro orderentity.OrderRepository, rp productentity.ProductRepository, rq productentity.QuantityRepository) *Service {
-	return &Service{ri: ri, rgi: rgi, ro: ro, rp: rp, rq: rq}
+func NewService(ri itementity.ItemRepository, rgi groupitementity.GroupItemRepository, ro orderentity.OrderRepository, rp productentity.ProductRepository, rq productentity.QuantityRepository, rc companyentity.CompanyRepository, rpl productentity.PriceListRepository) *Service {
+	return &Service{ri: ri, rgi: rgi, ro: ro, rp: rp, rq: rq, rc: rc, rpl: rpl}
 }
 
 func (s *Service) AddItemOrder(ctx context.Context, dto *itemdto.AddItemOrderInput) (ids *itemdto.ItemIDAndGroupItemOutput, err error) {
-	if _, err := s.ro.GetOrderById(ctx, dto.OrderID.String()); err != nil {
+	order, err := s.ro.GetOrderById(ctx, dto.OrderID.String())
+
+	if err != nil {
 		return nil, err
 	}
 
@@ -44,10 +51,39 @@ func (s *Service) AddItemOrder(ctx context.Context, dto *itemdto.AddItemOrderInp
 		return nil, errors.New("product not found: " + err.Error())
 	}
 
+	// combos are added by AddComboOrder, with the components of each slot and their upcharges
+	if product.IsCombo() {
+		return nil, productentity.ErrComboMustBeAddedAsCombo
+	}
+
 	if product.Category == nil {
 		return nil, ErrCategoryNotFound
 	}
 
+	now, err := s.companyNow(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !product.IsAvailableAt(now) {
+		return nil, productentity.ErrProductUnavailable
+	}
+
+	if dto.SizeID != nil {
+		if err := product.ApplyVariant(*dto.SizeID); err != nil {
+			return nil, err
+		}
+	}
+
+	appliedPrice, err := s.resolvePrice(ctx, order, product, now)
+
+	if err != nil {
+		return nil, err
+	}
+
+	product.Price = appliedPrice.Price
+
 	if product.Size == nil {
 		return nil, ErrSizeNotFound
 	}
@@ -68,6 +104,10 @@ func (s *Service) AddItemOrder(ctx context.Context, dto *itemdto.AddItemOrderInp
 		return nil, errors.New("group item not found: " + err.Error())
 	}
 
+	if groupItem.OrderID != dto.OrderID {
+		return nil, ErrGroupNotFromOrder
+	}
+
 	if !groupItem.CanAddItems() {
 		return nil, ErrGroupNotStaging
 	}
@@ -84,6 +124,9 @@ func (s *Service) AddItemOrder(ctx context.Context, dto *itemdto.AddItemOrderInp
 		return nil, err
 	}
 
+	item.ProductPrice = appliedPrice.BasePrice
+	item.AddPriceRule(appliedPrice.BasePrice, appliedPrice.PriceListID, appliedPrice.PriceRuleID, appliedPrice.Description)
+
 	if err = s.ri.AddItem(ctx, item); err != nil {
 		return nil, errors.New("add item error: " + err.Error())
 	}
@@ -103,6 +146,38 @@ func (s *Service) AddItemOrder(ctx context.Context, dto *itemdto.AddItemOrderInp
 	return itemdto.NewOutput(item.ID, groupItem.ID), nil
 }
 
+func (s *Service) companyNow(ctx context.Context) (time.Time, error) {
+	company, err := s.rc.GetCompany(ctx)
+
+	if err != nil {
+		return time.Time{}, errors.New("company not found: " + err.Error())
+	}
+
+	return company.Now(), nil
+}
+
+func (s *Service) resolvePrice(ctx context.Context, order *orderentity.Order, product *productentity.Product, now time.Time) (*productentity.AppliedPrice, error) {
+	priceLists, err := s.rpl.GetActivePriceLists(ctx)
+
+	if err != nil {
+		return nil, errors.New("price lists not found: " + err.Error())
+	}
+
+	return productentity.ResolvePrice(priceLists, product, priceChannel(order), now), nil
+}
+
+func priceChannel(order *orderentity.Order) productentity.PriceChannel {
+	if order.Delivery != nil {
+		return productentity.PriceChannelDelivery
+	}
+
+	if order.Pickup != nil {
+		return productentity.PriceChannelPickup
+	}
+
+	return productentity.PriceChannelTable
+}
+
 func (s *Service) DeleteItemOrder(ctx context.Context, dto *entitydto.IdRequest) (err error) {
 	item, err := s.ri.GetItemById(ctx, dto.ID.String())
 
@@ -245,6 +320,12 @@ func (s *Service) DeleteAdditionalItemOrder(ctx context.Context, dto *entitydto.
 }
 
 func (s *Service) newGroupItem(ctx context.Context, orderID uuid.UUID, product *productentity.Product) (groupItem *groupitementity.GroupItem, err error) {
+	groupItem = buildGroupItem(orderID, product)
+	err = s.rgi.CreateGroupItem(ctx, groupItem)
+	return
+}
+
+func buildGroupItem(orderID uuid.UUID, product *productentity.Product) *groupitementity.GroupItem {
 	groupCommonAttributes := groupitementity.GroupCommonAttributes{
 		OrderID: orderID,
 		GroupDetails: groupitementity.GroupDetails{
@@ -254,7 +335,5 @@ func (s *Service) newGroupItem(ctx context.Context, orderID uuid.UUID, product *
 		},
 	}
 
-	groupItem = groupitementity.NewGroupItem(groupCommonAttributes)
-	err = s.rgi.CreateGroupItem(ctx, groupItem)
-	return
+	return groupitementity.NewGroupItem(groupCommonAttributes)
 }
@@ -0,0 +1,316 @@
+package loyaltyusecases
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	cliententity "github.com/willjrcom/sales-backend-go/internal/domain/client"
+	loyaltyentity "github.com/willjrcom/sales-backend-go/internal/domain/loyalty"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+	productentity "github.com/willjrcom/sales-backend-go/internal/domain/product"
+	shiftentity "github.com/willjrcom/sales-backend-go/internal/domain/shift"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	loyaltydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/loyalty"
+)
+
+type Service struct {
+	rp  loyaltyentity.ProgramRepository
+	rr  loyaltyentity.RewardRepository
+	rt  loyaltyentity.TransactionRepository
+	rc  cliententity.Repository
+	ro  orderentity.OrderRepository
+	rs  shiftentity.ShiftRepository
+	rpr productentity.ProductRepository
+}
+
+func NewService(rp loyaltyentity.ProgramRepository, rr loyaltyentity.RewardRepository, rt loyaltyentity.TransactionRepository, rc cliententity.Repository, ro orderentity.OrderRepository, rs shiftentity.ShiftRepository, rpr productentity.ProductRepository) *Service {
+	return &Service{rp: rp, rr: rr, rt: rt, rc: rc, ro: ro, rs: rs, rpr: rpr}
+}
+
+func (s *Service) GetProgram(ctx context.Context) (*loyaltydto.ProgramOutput, error) {
+	program, err := s.getProgram(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	output := &loyaltydto.ProgramOutput{}
+	output.FromModel(program)
+	return output, nil
+}
+
+func (s *Service) UpdateProgram(ctx context.Context, dto *loyaltydto.UpdateProgramInput) (*loyaltydto.ProgramOutput, error) {
+	program, err := s.getProgram(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := dto.UpdateModel(program); err != nil {
+		return nil, err
+	}
+
+	if err := s.rp.SaveProgram(ctx, program); err != nil {
+		return nil, err
+	}
+
+	output := &loyaltydto.ProgramOutput{}
+	output.FromModel(program)
+	return output, nil
+}
+
+func (s *Service) RegisterReward(ctx context.Context, dto *loyaltydto.RegisterRewardInput) (uuid.UUID, error) {
+	reward, err := dto.ToModel()
+
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if err := s.rr.RegisterReward(ctx, reward); err != nil {
+		return uuid.Nil, err
+	}
+
+	return reward.ID, nil
+}
+
+func (s *Service) UpdateReward(ctx context.Context, dtoId *entitydto.IdRequest, dto *loyaltydto.UpdateRewardInput) error {
+	reward, err := s.rr.GetRewardById(ctx, dtoId.ID.String())
+
+	if err != nil {
+		return err
+	}
+
+	if err := dto.UpdateModel(reward); err != nil {
+		return err
+	}
+
+	return s.rr.UpdateReward(ctx, reward)
+}
+
+func (s *Service) GetAllRewards(ctx context.Context) ([]loyaltydto.RewardOutput, error) {
+	rewards, err := s.rr.GetAllRewards(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := []loyaltydto.RewardOutput{}
+	for i := range rewards {
+		output := loyaltydto.RewardOutput{}
+		output.FromModel(&rewards[i])
+		outputs = append(outputs, output)
+	}
+
+	return outputs, nil
+}
+
+// GetStatement returns the balance and every transaction of the client, newest first.
+func (s *Service) GetStatement(ctx context.Context, dtoId *entitydto.IdRequest) (*loyaltydto.StatementOutput, error) {
+	if _, err := s.rc.GetClientById(ctx, dtoId.ID.String()); err != nil {
+		return nil, err
+	}
+
+	transactions, err := s.rt.GetTransactionsByClientID(ctx, dtoId.ID.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	output := &loyaltydto.StatementOutput{}
+	output.FromModel(dtoId.ID, transactions)
+	return output, nil
+}
+
+// RedeemReward spends the points of the client as a discount on the order and records the redeem on the shift of the order,
+// the balance check and every write run in one transaction.
+func (s *Service) RedeemReward(ctx context.Context, dto *loyaltydto.RedeemRewardInput) (*loyaltydto.StatementOutput, error) {
+	if err := dto.Validate(); err != nil {
+		return nil, err
+	}
+
+	program, err := s.getProgram(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !program.IsActive {
+		return nil, loyaltyentity.ErrProgramInactive
+	}
+
+	if _, err := s.rc.GetClientById(ctx, dto.ClientID.String()); err != nil {
+		return nil, err
+	}
+
+	reward, err := s.rr.GetRewardById(ctx, dto.RewardID.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := s.ro.GetOrderById(ctx, dto.OrderID.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	productPrice := 0.0
+	if reward.Type == loyaltyentity.RewardTypeFreeProduct {
+		product, err := s.rpr.GetProductById(ctx, reward.ProductID.String())
+
+		if err != nil {
+			return nil, err
+		}
+
+		productPrice = product.Price
+	}
+
+	discount, err := reward.DiscountOn(order.TotalPayable, productPrice)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := order.AddDiscount(discount); err != nil {
+		return nil, err
+	}
+
+	transaction := loyaltyentity.NewRedeemTransaction(dto.ClientID, order.ID, reward, discount)
+	redeem := &shiftentity.Redeem{
+		TransactionID: transaction.ID,
+		ClientID:      dto.ClientID,
+		OrderID:       order.ID,
+		RewardName:    reward.Name,
+		Points:        reward.Points,
+		Discount:      discount,
+		RedeemedAt:    transaction.CreatedAt,
+	}
+
+	// the balance is checked again with the client locked, with the discount and the shift saved together
+	if err := s.rt.RedeemReward(ctx, transaction, order, redeem); err != nil {
+		return nil, err
+	}
+
+	return s.GetStatement(ctx, &entitydto.IdRequest{ID: dto.ClientID})
+}
+
+// EarnPoints credits the points of a finished order, to the client of the delivery or to the client that redeemed on it.
+func (s *Service) EarnPoints(ctx context.Context, order *orderentity.Order) error {
+	program, err := s.getProgram(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	if !program.IsActive {
+		return nil
+	}
+
+	transactions, err := s.rt.GetTransactionsByOrderID(ctx, order.ID.String())
+
+	if err != nil {
+		return err
+	}
+
+	var clientID *uuid.UUID
+	if order.Delivery != nil {
+		clientID = &order.Delivery.ClientID
+	}
+
+	for i := range transactions {
+		if transactions[i].Type == loyaltyentity.TransactionTypeEarn && transactions[i].ReversedAt == nil {
+			return nil
+		}
+
+		if clientID == nil && transactions[i].Type == loyaltyentity.TransactionTypeRedeem {
+			clientID = &transactions[i].ClientID
+		}
+	}
+
+	if clientID == nil {
+		return nil
+	}
+
+	amount := order.TotalPayable
+	if order.Delivery != nil && order.Delivery.DeliveryTax != nil {
+		amount -= *order.Delivery.DeliveryTax
+	}
+
+	points := program.PointsFor(amount)
+	if points == 0 {
+		return nil
+	}
+
+	return s.rt.RegisterTransaction(ctx, loyaltyentity.NewEarnTransaction(*clientID, order.ID, order.OrderNumber, points))
+}
+
+// ReverseOrder reverses the points earned and redeemed on the order, used on cancel and refund.
+func (s *Service) ReverseOrder(ctx context.Context, dtoId *entitydto.IdRequest) error {
+	order, err := s.ro.GetOrderById(ctx, dtoId.ID.String())
+
+	if err != nil {
+		return err
+	}
+
+	return s.ReversePoints(ctx, order)
+}
+
+func (s *Service) ReversePoints(ctx context.Context, order *orderentity.Order) error {
+	transactions, err := s.rt.GetTransactionsByOrderID(ctx, order.ID.String())
+
+	if err != nil {
+		return err
+	}
+
+	var shift *shiftentity.Shift
+	now := time.Now()
+
+	for i := range transactions {
+		transaction := &transactions[i]
+		if !transaction.IsReversible() {
+			continue
+		}
+
+		reversal, err := transaction.Reverse(now)
+
+		if err != nil {
+			return err
+		}
+
+		if err := s.rt.ReverseTransaction(ctx, transaction, reversal); err != nil {
+			return err
+		}
+
+		if transaction.Type != loyaltyentity.TransactionTypeRedeem || order.ShiftID == nil {
+			continue
+		}
+
+		if shift == nil {
+			if shift, err = s.rs.GetShiftByID(ctx, order.ShiftID.String()); err != nil {
+				return err
+			}
+		}
+
+		shift.ReverseRedeem(transaction.ID, now)
+	}
+
+	if shift != nil {
+		return s.rs.UpdateShift(ctx, shift)
+	}
+
+	return nil
+}
+
+// getProgram returns the program of the company, a new inactive one when it was never configured.
+func (s *Service) getProgram(ctx context.Context) (*loyaltyentity.LoyaltyProgram, error) {
+	program, err := s.rp.GetProgram(ctx)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return loyaltyentity.NewLoyaltyProgram(), nil
+	}
+
+	return program, err
+}
@@ -0,0 +1,99 @@
+package menuusecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+	productentity "github.com/willjrcom/sales-backend-go/internal/domain/product"
+	schemaentity "github.com/willjrcom/sales-backend-go/internal/domain/schema"
+	menudto "github.com/willjrcom/sales-backend-go/internal/infra/dto/menu"
+)
+
+var (
+	ErrSlugRequired = errors.New("company slug is required")
+)
+
+type Service struct {
+	rm companyentity.CompanyRepository
+	rc productentity.CategoryRepository
+	rp productentity.ProductRepository
+}
+
+func NewService(rm companyentity.CompanyRepository, rc productentity.CategoryRepository, rp productentity.ProductRepository) *Service {
+	return &Service{rm: rm, rc: rc, rp: rp}
+}
+
+// GetPublicMenu returns the categories open now with active sizes and available products of the company.
+func (s *Service) GetPublicMenu(ctx context.Context, slug string) (*menudto.MenuOutput, error) {
+	if slug == "" {
+		return nil, ErrSlugRequired
+	}
+
+	company, err := s.rm.GetCompanyBySlug(ctx, slug)
+
+	if err != nil {
+		return nil, errors.New("company not found: " + err.Error())
+	}
+
+	ctx = context.WithValue(ctx, schemaentity.Schema("schema"), company.SchemaName)
+	return s.buildMenu(ctx, company)
+}
+
+// GetMenu returns the menu of the company on the context schema.
+func (s *Service) GetMenu(ctx context.Context) (*menudto.MenuOutput, error) {
+	company, err := s.rm.GetCompany(ctx)
+
+	if err != nil {
+		return nil, errors.New("company not found: " + err.Error())
+	}
+
+	return s.buildMenu(ctx, company)
+}
+
+func (s *Service) buildMenu(ctx context.Context, company *companyentity.Company) (*menudto.MenuOutput, error) {
+	categories, err := s.rc.GetAllCategories(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	products, err := s.rp.GetAllProducts(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	now := company.Now()
+	productsByCategory := map[uuid.UUID][]productentity.Product{}
+
+	for _, product := range products {
+		if product.IsAvailableAt(now) {
+			productsByCategory[product.CategoryID] = append(productsByCategory[product.CategoryID], product)
+		}
+	}
+
+	menuCategories := []productentity.Category{}
+
+	for _, category := range categories {
+		if len(productsByCategory[category.ID]) == 0 || !productentity.IsScheduledAt(category.Schedules, now) {
+			continue
+		}
+
+		sizes := []productentity.Size{}
+		for _, size := range category.Sizes {
+			if size.Active == nil || *size.Active {
+				sizes = append(sizes, size)
+			}
+		}
+
+		category.Sizes = sizes
+		category.Products = productsByCategory[category.ID]
+		menuCategories = append(menuCategories, category)
+	}
+
+	menu := &menudto.MenuOutput{}
+	menu.FromModel(company, menuCategories)
+	return menu, nil
+}
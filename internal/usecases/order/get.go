@@ -3,8 +3,11 @@ package orderusecases
 import (
 	"context"
 
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
 	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
 	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	trackingdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/tracking"
+	jwtservice "github.com/willjrcom/sales-backend-go/internal/infra/service/jwt"
 )
 
 func (s *Service) GetOrderById(ctx context.Context, dto *entitydto.IdRequest) (*orderentity.Order, error) {
@@ -12,6 +15,7 @@ func (s *Service) GetOrderById(ctx context.Context, dto *entitydto.IdRequest) (*
 		return nil, err
 	} else {
 		order.CalculateTotalPrice()
+		s.es.EstimateOrders(ctx, order)
 		return order, nil
 	}
 }
@@ -20,6 +24,12 @@ func (s *Service) GetAllOrders(ctx context.Context) ([]orderentity.Order, error)
 	if orders, err := s.ro.GetAllOrders(ctx); err != nil {
 		return nil, err
 	} else {
+		estimated := []*orderentity.Order{}
+		for i := range orders {
+			estimated = append(estimated, &orders[i])
+		}
+
+		s.es.EstimateOrders(ctx, estimated...)
 		return orders, nil
 	}
 }
@@ -27,3 +37,26 @@ func (s *Service) GetAllOrders(ctx context.Context) ([]orderentity.Order, error)
 func (s *Service) GetAllDeliveryOrderStatus(ctx context.Context) ([]orderentity.Order, error) {
 	return []orderentity.Order{}, nil
 }
+
+// GetTrackingToken returns the token of the public tracking link of the order.
+func (s *Service) GetTrackingToken(ctx context.Context, dto *entitydto.IdRequest) (*trackingdto.TrackingTokenOutput, error) {
+	order, err := s.ro.GetOrderById(ctx, dto.ID.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	schema, err := database.GetSchema(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := jwtservice.CreateOrderTrackingToken(schema, order.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &trackingdto.TrackingTokenOutput{Token: token}, nil
+}
@@ -3,15 +3,19 @@ package orderusecases
 import (
 	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
 	shiftentity "github.com/willjrcom/sales-backend-go/internal/domain/shift"
+	etausecases "github.com/willjrcom/sales-backend-go/internal/usecases/eta"
 	groupitemusecases "github.com/willjrcom/sales-backend-go/internal/usecases/group_item"
+	loyaltyusecases "github.com/willjrcom/sales-backend-go/internal/usecases/loyalty"
 )
 
 type Service struct {
 	ro  orderentity.OrderRepository
 	rs  shiftentity.ShiftRepository
 	rgi *groupitemusecases.Service
+	ls  *loyaltyusecases.Service
+	es  *etausecases.Service
 }
 
-func NewService(ro orderentity.OrderRepository, rs shiftentity.ShiftRepository, rgi *groupitemusecases.Service) *Service {
-	return &Service{ro: ro, rs: rs, rgi: rgi}
+func NewService(ro orderentity.OrderRepository, rs shiftentity.ShiftRepository, rgi *groupitemusecases.Service, ls *loyaltyusecases.Service, es *etausecases.Service) *Service {
+	return &Service{ro: ro, rs: rs, rgi: rgi, ls: ls, es: es}
 }
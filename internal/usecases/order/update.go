@@ -2,7 +2,9 @@ package orderusecases
 
 import (
 	"context"
+	"log"
 
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
 	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
 	orderdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/order"
 )
@@ -40,6 +42,11 @@ func (s *Service) FinishOrder(ctx context.Context, dto *entitydto.IdRequest) err
 		return err
 	}
 
+	// the order is already finished, a failure on the points must not report the finish as failed
+	if err := s.ls.EarnPoints(ctx, order); err != nil {
+		log.Printf("earn points of order %s error: %s", order.ID, err.Error())
+	}
+
 	return nil
 }
 
@@ -65,9 +72,18 @@ func (s *Service) CancelOrder(ctx context.Context, dto *entitydto.IdRequest) (er
 		}
 	}
 
+	s.ReverseCanceledPoints(ctx, order)
 	return nil
 }
 
+// ReverseCanceledPoints reverses the loyalty points of the canceled order,
+// the order is already canceled, the points can still be reversed by the loyalty reverse route.
+func (s *Service) ReverseCanceledPoints(ctx context.Context, order *orderentity.Order) {
+	if err := s.ls.ReversePoints(ctx, order); err != nil {
+		log.Printf("reverse points of order %s error: %s", order.ID, err.Error())
+	}
+}
+
 func (s *Service) ArchiveOrder(ctx context.Context, dto *entitydto.IdRequest) (err error) {
 	order, err := s.ro.GetOrderById(ctx, dto.ID.String())
 
@@ -105,33 +121,85 @@ func (s *Service) UnarchiveOrder(ctx context.Context, dto *entitydto.IdRequest)
 }
 
 func (s *Service) AddPayment(ctx context.Context, dto *entitydto.IdRequest, dtoPayment *orderdto.AddPaymentMethod) error {
-	order, err := s.ro.GetOrderById(ctx, dto.ID.String())
+	order, paymentOrder, err := s.NewPayment(ctx, dto, dtoPayment)
 
 	if err != nil {
 		return err
 	}
 
-	if err = order.ValidatePayments(); err != nil {
+	if err := s.ro.AddPaymentOrder(ctx, paymentOrder); err != nil {
+		return err
+	}
+
+	if err := s.ro.UpdateOrder(ctx, order); err != nil {
 		return err
 	}
 
+	return nil
+}
+
+// NewPayment adds the payment to the order without saving them, for callers saving the payment with other changes.
+func (s *Service) NewPayment(ctx context.Context, dto *entitydto.IdRequest, dtoPayment *orderdto.AddPaymentMethod) (*orderentity.Order, *orderentity.PaymentOrder, error) {
+	order, err := s.ro.GetOrderById(ctx, dto.ID.String())
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err = order.ValidatePayments(); err != nil {
+		return nil, nil, err
+	}
+
 	paymentOrder, err := dtoPayment.ToModel(order)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	order.AddPayment(paymentOrder)
 
 	order.CalculateTotalPrice()
-	if err := s.ro.AddPaymentOrder(ctx, paymentOrder); err != nil {
+	return order, paymentOrder, nil
+}
+
+// AwaitOnlinePayment keeps the order out of the kitchen until its online payment is confirmed.
+func (s *Service) AwaitOnlinePayment(ctx context.Context, dto *entitydto.IdRequest, method orderentity.PayMethod) error {
+	order, err := s.ro.GetOrderById(ctx, dto.ID.String())
+
+	if err != nil {
 		return err
 	}
 
-	if err := s.ro.UpdateOrder(ctx, order); err != nil {
+	order.AwaitPayment(method)
+	return s.ro.UpdateOrder(ctx, order)
+}
+
+// ConfirmOnlinePayment pays the order with the awaited method and sends it to the kitchen,
+// it is called when the payment provider confirms the payment.
+func (s *Service) ConfirmOnlinePayment(ctx context.Context, dto *entitydto.IdRequest) error {
+	order, err := s.ro.GetOrderById(ctx, dto.ID.String())
+
+	if err != nil {
 		return err
 	}
 
-	return nil
+	payment, err := order.ConfirmPayment()
+
+	if err != nil {
+		return err
+	}
+
+	order.CalculateTotalPrice()
+
+	// validated before any write, the order is saved with the payment when sent to the kitchen
+	if err := order.PendingOrder(); err != nil {
+		return err
+	}
+
+	if err := s.ro.AddPaymentOrder(ctx, payment); err != nil {
+		return err
+	}
+
+	return s.ro.PendingOrder(ctx, order)
 }
 
 func (s *Service) UpdateOrderObservation(ctx context.Context, dtoId *entitydto.IdRequest, dto *orderdto.UpdateObservationOrder) error {
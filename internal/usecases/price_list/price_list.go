@@ -0,0 +1,89 @@
+package pricelistusecases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	productentity "github.com/willjrcom/sales-backend-go/internal/domain/product"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	pricelistdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/price_list"
+)
+
+type Service struct {
+	r productentity.PriceListRepository
+}
+
+func NewService(r productentity.PriceListRepository) *Service {
+	return &Service{r: r}
+}
+
+func (s *Service) RegisterPriceList(ctx context.Context, dto *pricelistdto.RegisterPriceListInput) (uuid.UUID, error) {
+	priceList, err := dto.ToModel()
+
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if err = s.r.RegisterPriceList(ctx, priceList); err != nil {
+		return uuid.Nil, err
+	}
+
+	return priceList.ID, nil
+}
+
+func (s *Service) UpdatePriceList(ctx context.Context, dtoId *entitydto.IdRequest, dto *pricelistdto.UpdatePriceListInput) error {
+	priceList, err := s.r.GetPriceListById(ctx, dtoId.ID.String())
+
+	if err != nil {
+		return err
+	}
+
+	if err = dto.UpdateModel(priceList); err != nil {
+		return err
+	}
+
+	if err = s.r.UpdatePriceList(ctx, priceList); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) DeletePriceList(ctx context.Context, dto *entitydto.IdRequest) error {
+	if _, err := s.r.GetPriceListById(ctx, dto.ID.String()); err != nil {
+		return err
+	}
+
+	if err := s.r.DeletePriceList(ctx, dto.ID.String()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) GetPriceListById(ctx context.Context, dto *entitydto.IdRequest) (*pricelistdto.PriceListOutput, error) {
+	priceList, err := s.r.GetPriceListById(ctx, dto.ID.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	output := &pricelistdto.PriceListOutput{}
+	output.FromModel(priceList)
+	return output, nil
+}
+
+func (s *Service) GetAllPriceLists(ctx context.Context) ([]pricelistdto.PriceListOutput, error) {
+	priceLists, err := s.r.GetAllPriceLists(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := make([]pricelistdto.PriceListOutput, len(priceLists))
+	for i := range priceLists {
+		outputs[i].FromModel(&priceLists[i])
+	}
+
+	return outputs, nil
+}
@@ -3,8 +3,10 @@ package productusecases
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/google/uuid"
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
 	productentity "github.com/willjrcom/sales-backend-go/internal/domain/product"
 	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
 	productdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/product"
@@ -17,10 +19,12 @@ var (
 type Service struct {
 	rp productentity.ProductRepository
 	rc productentity.CategoryRepository
+	rm companyentity.CompanyRepository
+	rh productentity.ProductPriceChangeRepository
 }
 
-func NewService(r productentity.ProductRepository, c productentity.CategoryRepository) *Service {
-	return &Service{rp: r, rc: c}
+func NewService(r productentity.ProductRepository, c productentity.CategoryRepository, m companyentity.CompanyRepository, h productentity.ProductPriceChangeRepository) *Service {
+	return &Service{rp: r, rc: c, rm: m, rh: h}
 }
 
 func (s *Service) RegisterProduct(ctx context.Context, dto *productdto.RegisterProductInput) (uuid.UUID, error) {
@@ -44,6 +48,10 @@ func (s *Service) RegisterProduct(ctx context.Context, dto *productdto.RegisterP
 		return uuid.Nil, err
 	}
 
+	if err := product.ValidateVariants(); err != nil {
+		return uuid.Nil, err
+	}
+
 	// imagePath, err := s3.UploadToS3(dto.Image)
 	// if err != nil {
 	// 	fmt.Printf("Erro ao fazer upload: %v\n", err)
@@ -75,6 +83,8 @@ func (s *Service) UpdateProduct(ctx context.Context, dtoId *entitydto.IdRequest,
 		return err
 	}
 
+	oldPrice, oldCost := product.Price, product.Cost
+
 	if err := dto.UpdateModel(product); err != nil {
 		return err
 	}
@@ -83,11 +93,147 @@ func (s *Service) UpdateProduct(ctx context.Context, dtoId *entitydto.IdRequest,
 		return errors.New("code product already exists")
 	}
 
-	if err := s.rp.UpdateProduct(ctx, product); err != nil {
+	if product.HasVariants() {
+		if product.Category, err = s.rc.GetCategoryById(ctx, product.CategoryID.String()); err != nil {
+			return err
+		}
+
+		if err := product.ValidateVariants(); err != nil {
+			return err
+		}
+	}
+
+	if product.Price == oldPrice && product.Cost == oldCost {
+		return s.rp.UpdateProduct(ctx, product)
+	}
+
+	change := productentity.NewAppliedPriceChange(product, oldPrice, oldCost, companyentity.GetUserIDFromContext(ctx))
+	return s.rp.UpdateProductWithPriceChange(ctx, product, change)
+}
+
+func (s *Service) SchedulePriceChange(ctx context.Context, dtoId *entitydto.IdRequest, dto *productdto.SchedulePriceInput) (uuid.UUID, error) {
+	if _, err := s.rp.GetProductById(ctx, dtoId.ID.String()); err != nil {
+		return uuid.Nil, err
+	}
+
+	change, err := dto.ToModel(dtoId.ID, companyentity.GetUserIDFromContext(ctx))
+
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if err := s.rh.RegisterPriceChange(ctx, change); err != nil {
+		return uuid.Nil, err
+	}
+
+	return change.ID, nil
+}
+
+func (s *Service) GetPriceHistory(ctx context.Context, dtoId *entitydto.IdRequest) ([]productdto.PriceChangeOutput, error) {
+	changes, err := s.rh.GetPriceChangesByProductId(ctx, dtoId.ID.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := make([]productdto.PriceChangeOutput, len(changes))
+	for i := range changes {
+		outputs[i].FromModel(&changes[i])
+	}
+
+	return outputs, nil
+}
+
+// ApplyScheduledPriceChanges applies the price changes that became effective, the schema must be in the context.
+// A failed change is skipped and tried again on the next run, it never blocks the other changes.
+func (s *Service) ApplyScheduledPriceChanges(ctx context.Context) error {
+	changes, err := s.rh.GetPendingPriceChanges(ctx, time.Now().UTC())
+
+	if err != nil {
 		return err
 	}
 
-	return nil
+	errs := []error{}
+	for i := range changes {
+		if err := s.applyPriceChange(ctx, &changes[i]); err != nil {
+			errs = append(errs, errors.New("price change "+changes[i].ID.String()+": "+err.Error()))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s *Service) applyPriceChange(ctx context.Context, change *productentity.ProductPriceChange) error {
+	product, err := s.rp.GetProductById(ctx, change.ProductID.String())
+
+	if err != nil {
+		return errors.New("product not found: " + err.Error())
+	}
+
+	if err := change.Apply(product); err != nil {
+		return err
+	}
+
+	return s.rp.UpdateProductWithPriceChange(ctx, product, change)
+}
+
+// MergeProductsIntoVariants keeps the first product and turns the others into its size variants.
+func (s *Service) MergeProductsIntoVariants(ctx context.Context, dto *productdto.MergeVariantsInput) (uuid.UUID, error) {
+	productID, mergedIDs, err := dto.ToModel()
+
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	product, err := s.rp.GetProductById(ctx, productID.String())
+
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	products := []productentity.Product{}
+	ids := []string{}
+
+	for _, id := range mergedIDs {
+		p, err := s.rp.GetProductById(ctx, id.String())
+
+		if err != nil {
+			return uuid.Nil, err
+		}
+
+		products = append(products, *p)
+		ids = append(ids, id.String())
+	}
+
+	if err := product.MergeAsVariants(products); err != nil {
+		return uuid.Nil, err
+	}
+
+	if dto.Code != nil {
+		if p, _ := s.rp.GetProductByCode(ctx, *dto.Code); p != nil && p.ID != product.ID {
+			return uuid.Nil, errors.New("code product already exists")
+		}
+
+		product.Code = *dto.Code
+	}
+
+	if dto.Name != nil {
+		product.Name = *dto.Name
+	}
+
+	if product.Category, err = s.rc.GetCategoryById(ctx, product.CategoryID.String()); err != nil {
+		return uuid.Nil, err
+	}
+
+	if err := product.ValidateVariants(); err != nil {
+		return uuid.Nil, err
+	}
+
+	if err := s.rp.MergeProducts(ctx, product, ids); err != nil {
+		return uuid.Nil, err
+	}
+
+	return product.ID, nil
 }
 
 func (s *Service) DeleteProductById(ctx context.Context, dto *entitydto.IdRequest) error {
@@ -123,6 +269,117 @@ func (s *Service) GetAllProducts(ctx context.Context) ([]productdto.ProductOutpu
 	return dtos, nil
 }
 
+// GetAvailableProducts returns only the products that can be ordered now in the company timezone.
+func (s *Service) GetAvailableProducts(ctx context.Context) ([]productdto.ProductOutput, error) {
+	company, err := s.rm.GetCompany(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	products, err := s.rp.GetAllProducts(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	now := company.Now()
+	availableProducts := []productentity.Product{}
+
+	for _, product := range products {
+		if product.IsAvailableAt(now) {
+			availableProducts = append(availableProducts, product)
+		}
+	}
+
+	return productsToDtos(availableProducts), nil
+}
+
+// BulkAdjustPrices changes price or cost of the filtered products, on preview nothing is saved.
+func (s *Service) BulkAdjustPrices(ctx context.Context, dto *productdto.BulkPriceInput, preview bool) (*productdto.ProductBatchOutput, error) {
+	if err := dto.Validate(); err != nil {
+		return nil, err
+	}
+
+	products, err := s.rp.GetAllProducts(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	userID := companyentity.GetUserIDFromContext(ctx)
+	batch := productentity.NewProductBatch(productentity.BatchTypePrice, dto.Filter, userID)
+	changed, err := batch.AdjustPrices(products, dto.PriceAdjustment)
+
+	if err != nil {
+		return nil, err
+	}
+
+	oldProducts := map[uuid.UUID]productentity.Product{}
+	for _, product := range products {
+		oldProducts[product.ID] = product
+	}
+
+	priceChanges := []productentity.ProductPriceChange{}
+	for i := range changed {
+		old := oldProducts[changed[i].ID]
+		if old.Price != changed[i].Price || old.Cost != changed[i].Cost {
+			priceChanges = append(priceChanges, *productentity.NewAppliedPriceChange(&changed[i], old.Price, old.Cost, userID))
+		}
+	}
+
+	return s.applyProductBatch(ctx, batch, changed, priceChanges, preview)
+}
+
+// BulkSetAvailability turns on or off the filtered products, on preview nothing is saved.
+func (s *Service) BulkSetAvailability(ctx context.Context, dto *productdto.BulkAvailabilityInput, preview bool) (*productdto.ProductBatchOutput, error) {
+	if err := dto.Validate(); err != nil {
+		return nil, err
+	}
+
+	products, err := s.rp.GetAllProducts(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	batch := productentity.NewProductBatch(productentity.BatchTypeAvailability, dto.Filter, companyentity.GetUserIDFromContext(ctx))
+	changed, err := batch.SetAvailability(products, *dto.IsAvailable)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return s.applyProductBatch(ctx, batch, changed, nil, preview)
+}
+
+func (s *Service) applyProductBatch(ctx context.Context, batch *productentity.ProductBatch, products []productentity.Product, priceChanges []productentity.ProductPriceChange, preview bool) (*productdto.ProductBatchOutput, error) {
+	if !preview {
+		if err := s.rp.ApplyProductBatch(ctx, batch, products, priceChanges); err != nil {
+			return nil, err
+		}
+	}
+
+	output := &productdto.ProductBatchOutput{}
+	output.FromModel(batch, preview)
+	return output, nil
+}
+
+func (s *Service) GetAllProductBatches(ctx context.Context) ([]productdto.ProductBatchOutput, error) {
+	batches, err := s.rp.GetAllProductBatches(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := make([]productdto.ProductBatchOutput, len(batches))
+	for i := range batches {
+		outputs[i].FromModel(&batches[i], false)
+	}
+
+	return outputs, nil
+}
+
 func productsToDtos(products []productentity.Product) []productdto.ProductOutput {
 	dtos := make([]productdto.ProductOutput, len(products))
 	for i, product := range products {
@@ -36,7 +36,7 @@ func TestMain(m *testing.M) {
 	rs := sizerepositorylocal.NewSizeRepositoryLocal()
 
 	// Service
-	productService = NewService(rp, rc)
+	productService = NewService(rp, rc, nil, nil)
 	sizeService = sizeusecases.NewService(rs, rc)
 
 	exitCode := m.Run()
@@ -0,0 +1,176 @@
+package selforderusecases
+
+import (
+	"context"
+	"errors"
+
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+	schemaentity "github.com/willjrcom/sales-backend-go/internal/domain/schema"
+	tableentity "github.com/willjrcom/sales-backend-go/internal/domain/table"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	itemdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/item"
+	menudto "github.com/willjrcom/sales-backend-go/internal/infra/dto/menu"
+	selforderdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/self_order"
+	jwtservice "github.com/willjrcom/sales-backend-go/internal/infra/service/jwt"
+	itemusecases "github.com/willjrcom/sales-backend-go/internal/usecases/item"
+	menuusecases "github.com/willjrcom/sales-backend-go/internal/usecases/menu"
+	orderusecases "github.com/willjrcom/sales-backend-go/internal/usecases/order"
+	tableorderusecases "github.com/willjrcom/sales-backend-go/internal/usecases/table_order"
+)
+
+var (
+	ErrSelfOrderNotOpened = errors.New("table order not opened, scan the QR code again")
+)
+
+// Service is the public ordering of customers on the table QR code, every call is authorized by the table public token.
+type Service struct {
+	rt  tableentity.TableRepository
+	rto orderentity.TableOrderRepository
+	tos *tableorderusecases.Service
+	os  *orderusecases.Service
+	is  *itemusecases.Service
+	ms  *menuusecases.Service
+}
+
+func NewService(rt tableentity.TableRepository, rto orderentity.TableOrderRepository, tos *tableorderusecases.Service, os *orderusecases.Service, is *itemusecases.Service, ms *menuusecases.Service) *Service {
+	return &Service{rt: rt, rto: rto, tos: tos, os: os, is: is, ms: ms}
+}
+
+func (s *Service) GetMenu(ctx context.Context, token string) (*menudto.MenuOutput, error) {
+	ctx, _, err := s.resolveTable(ctx, token)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return s.ms.GetMenu(ctx)
+}
+
+// OpenTableOrder joins the open order of the table, or opens a new one when the table is free.
+func (s *Service) OpenTableOrder(ctx context.Context, token string, dto *selforderdto.OpenSelfOrderInput) (*selforderdto.SelfOrderOutput, error) {
+	ctx, table, err := s.resolveTable(ctx, token)
+
+	if err != nil {
+		return nil, err
+	}
+
+	tableOrder, _ := s.rto.GetOpenTableOrderByTableID(ctx, table.ID.String())
+
+	if tableOrder == nil {
+		tableOrder = dto.ToModel(table)
+
+		if _, err := s.tos.OpenTableOrder(ctx, tableOrder); err != nil {
+			return nil, err
+		}
+	}
+
+	output := &selforderdto.SelfOrderOutput{}
+	output.FromModel(table, tableOrder)
+	return output, nil
+}
+
+// GetOrder returns the public view of the open order of the table.
+func (s *Service) GetOrder(ctx context.Context, token string) (*selforderdto.SelfOrderDetailOutput, error) {
+	ctx, _, tableOrder, err := s.resolveTableOrder(ctx, token)
+
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := s.os.GetOrderById(ctx, &entitydto.IdRequest{ID: tableOrder.OrderID})
+
+	if err != nil {
+		return nil, err
+	}
+
+	output := &selforderdto.SelfOrderDetailOutput{}
+	output.FromModel(order)
+	return output, nil
+}
+
+// AddItem adds the item and its additionals to the open order of the table.
+func (s *Service) AddItem(ctx context.Context, token string, dto *selforderdto.AddSelfOrderItemInput) (*itemdto.ItemIDAndGroupItemOutput, error) {
+	ctx, _, tableOrder, err := s.resolveTableOrder(ctx, token)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ids, err := s.is.AddItemOrder(ctx, dto.ToItemInput(tableOrder.OrderID))
+
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range dto.Additionals {
+		if _, err := s.is.AddAdditionalItemOrder(ctx, &entitydto.IdRequest{ID: ids.ItemID}, &dto.Additionals[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return ids, nil
+}
+
+// SubmitOrder sends the items to the kitchen or keeps them waiting for the staff, following the table mode.
+func (s *Service) SubmitOrder(ctx context.Context, token string) (*selforderdto.SelfOrderOutput, error) {
+	ctx, table, tableOrder, err := s.resolveTableOrder(ctx, token)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if table.SubmitsToKitchen() {
+		if err := s.os.PendingOrder(ctx, &entitydto.IdRequest{ID: tableOrder.OrderID}); err != nil {
+			return nil, err
+		}
+	} else {
+		tableOrder.RequestApproval()
+
+		if err := s.rto.UpdateTableOrder(ctx, tableOrder); err != nil {
+			return nil, err
+		}
+	}
+
+	output := &selforderdto.SelfOrderOutput{}
+	output.FromModel(table, tableOrder)
+	return output, nil
+}
+
+// resolveTable validates the token and returns the context with the schema of the company.
+func (s *Service) resolveTable(ctx context.Context, token string) (context.Context, *tableentity.Table, error) {
+	schema, tableID, nonce, err := jwtservice.ValidateTableToken(ctx, token)
+
+	if err != nil {
+		return nil, nil, tableentity.ErrPublicTokenInvalid
+	}
+
+	ctx = context.WithValue(ctx, schemaentity.Schema("schema"), schema)
+
+	table, err := s.rt.GetTableById(ctx, tableID.String())
+
+	if err != nil {
+		return nil, nil, tableentity.ErrPublicTokenInvalid
+	}
+
+	if err := table.ValidatePublicTokenNonce(nonce); err != nil {
+		return nil, nil, err
+	}
+
+	return ctx, table, nil
+}
+
+func (s *Service) resolveTableOrder(ctx context.Context, token string) (context.Context, *tableentity.Table, *orderentity.TableOrder, error) {
+	ctx, table, err := s.resolveTable(ctx, token)
+
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	tableOrder, err := s.rto.GetOpenTableOrderByTableID(ctx, table.ID.String())
+
+	if err != nil {
+		return nil, nil, nil, ErrSelfOrderNotOpened
+	}
+
+	return ctx, table, tableOrder, nil
+}
@@ -0,0 +1,148 @@
+package storefrontusecases
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	storefrontentity "github.com/willjrcom/sales-backend-go/internal/domain/storefront"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	storefrontdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/storefront"
+)
+
+func (s *Service) GetCart(ctx context.Context) (*storefrontdto.CartOutput, error) {
+	cart, err := s.getCart(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return s.cartOutput(ctx, cart)
+}
+
+func (s *Service) AddCartItem(ctx context.Context, dto *storefrontdto.AddCartItemInput) (*storefrontdto.CartOutput, error) {
+	cart, err := s.getCart(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := cart.AddItem(dto.CartItem); err != nil {
+		return nil, err
+	}
+
+	output, err := s.cartOutput(ctx, cart)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.rca.SaveCart(ctx, cart); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (s *Service) RemoveCartItem(ctx context.Context, dto *entitydto.IdRequest) (*storefrontdto.CartOutput, error) {
+	cart, err := s.getCart(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cart.RemoveItem(dto.ID); err != nil {
+		return nil, err
+	}
+
+	if err := s.rca.SaveCart(ctx, cart); err != nil {
+		return nil, err
+	}
+
+	return s.cartOutput(ctx, cart)
+}
+
+func (s *Service) ClearCart(ctx context.Context) error {
+	cart, err := s.getCart(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	cart.Clear()
+	return s.rca.SaveCart(ctx, cart)
+}
+
+// getCart returns the cart of the client, a new one when the client never added items.
+func (s *Service) getCart(ctx context.Context) (*storefrontentity.Cart, error) {
+	client, err := s.getClient(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	cart, err := s.rca.GetCartByClientID(ctx, client.ID.String())
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return storefrontentity.NewCart(client.ID), nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return cart, nil
+}
+
+// cartOutput loads the products of the cart, it fails when a product or quantity doesn't exist anymore.
+func (s *Service) cartOutput(ctx context.Context, cart *storefrontentity.Cart) (*storefrontdto.CartOutput, error) {
+	items := map[uuid.UUID]storefrontdto.CartProduct{}
+	additionals := map[uuid.UUID][]storefrontdto.CartProduct{}
+
+	for _, item := range cart.Items {
+		product, err := s.cartProduct(ctx, item.ProductID, item.QuantityID, item.SizeID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		items[item.ID] = *product
+
+		for _, additional := range item.Additionals {
+			product, err := s.cartProduct(ctx, additional.ProductID, additional.QuantityID, nil)
+
+			if err != nil {
+				return nil, err
+			}
+
+			additionals[item.ID] = append(additionals[item.ID], *product)
+		}
+	}
+
+	output := &storefrontdto.CartOutput{}
+	output.FromModel(cart, items, additionals)
+	return output, nil
+}
+
+func (s *Service) cartProduct(ctx context.Context, productID uuid.UUID, quantityID uuid.UUID, sizeID *uuid.UUID) (*storefrontdto.CartProduct, error) {
+	product, err := s.rp.GetProductById(ctx, productID.String())
+
+	if err != nil {
+		return nil, errors.New("product not found: " + err.Error())
+	}
+
+	if sizeID != nil {
+		if err := product.ApplyVariant(*sizeID); err != nil {
+			return nil, err
+		}
+	}
+
+	quantity, err := s.rq.GetQuantityById(ctx, quantityID.String())
+
+	if err != nil {
+		return nil, errors.New("quantity not found: " + err.Error())
+	}
+
+	return &storefrontdto.CartProduct{Product: product, Quantity: quantity.Quantity}, nil
+}
@@ -0,0 +1,170 @@
+package storefrontusecases
+
+import (
+	"context"
+	"log"
+
+	"github.com/google/uuid"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+	storefrontentity "github.com/willjrcom/sales-backend-go/internal/domain/storefront"
+	deliveryorderdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/delivery"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	itemdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/item"
+	orderdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/order"
+	pickuporderdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/pickup_order"
+	storefrontdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/storefront"
+)
+
+// Checkout creates the delivery or pickup order with the items of the cart, the order waits for the online payment
+// and goes to the kitchen when the payment provider confirms it.
+func (s *Service) Checkout(ctx context.Context, dto *storefrontdto.CheckoutInput) (*storefrontdto.CheckoutOutput, error) {
+	if err := dto.Validate(); err != nil {
+		return nil, err
+	}
+
+	client, err := s.getClient(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	cart, err := s.getCart(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if cart.IsEmpty() {
+		return nil, storefrontentity.ErrCartEmpty
+	}
+
+	output := &storefrontdto.CheckoutOutput{}
+
+	if dto.Type == storefrontdto.CheckoutTypeDelivery {
+		if _, err := s.deliveryQuote(ctx, client); err != nil {
+			return nil, err
+		}
+
+		ids, err := s.ds.CreateDeliveryOrder(ctx, &deliveryorderdto.CreateDeliveryOrderInput{ClientID: client.ID, AddressID: &client.Address.ID})
+
+		if err != nil {
+			return nil, err
+		}
+
+		output.OrderID = ids.OrderID
+		output.DeliveryID = &ids.DeliveryID
+	} else {
+		ids, err := s.ps.CreatePickupOrder(ctx, &pickuporderdto.CreatePickupOrderInput{Name: client.Name})
+
+		if err != nil {
+			return nil, err
+		}
+
+		output.OrderID = ids.OrderID
+		output.PickupID = &ids.PickupID
+	}
+
+	order, err := s.placeOrder(ctx, output.OrderID, cart, dto)
+
+	if err != nil {
+		// best effort, the order without items can also be canceled by the staff
+		if errCancel := s.os.CancelOrder(ctx, &entitydto.IdRequest{ID: output.OrderID}); errCancel != nil {
+			log.Printf("cancel order %s of failed checkout error: %s", output.OrderID, errCancel.Error())
+		}
+
+		return nil, err
+	}
+
+	cart.Clear()
+
+	if err := s.rca.SaveCart(ctx, cart); err != nil {
+		return nil, err
+	}
+
+	output.TotalPayable = order.TotalPayable
+	output.AwaitingPayment = order.IsAwaitingPayment()
+	output.Eta = order.Eta
+
+	if order.Delivery != nil && order.Delivery.DeliveryTax != nil {
+		output.DeliveryTax = *order.Delivery.DeliveryTax
+	}
+
+	tracking, err := s.os.GetTrackingToken(ctx, &entitydto.IdRequest{ID: order.ID})
+
+	if err != nil {
+		return nil, err
+	}
+
+	output.TrackingToken = tracking.Token
+	return output, nil
+}
+
+// placeOrder adds the items grouped by category and size, the order waits for the online payment
+// and is only sent to the kitchen when the payment provider confirms it.
+func (s *Service) placeOrder(ctx context.Context, orderID uuid.UUID, cart *storefrontentity.Cart, dto *storefrontdto.CheckoutInput) (*orderentity.Order, error) {
+	groups := map[string]uuid.UUID{}
+
+	for _, cartItem := range cart.Items {
+		product, err := s.rp.GetProductById(ctx, cartItem.ProductID.String())
+
+		if err != nil {
+			return nil, err
+		}
+
+		sizeID := product.SizeID
+		if cartItem.SizeID != nil {
+			sizeID = *cartItem.SizeID
+		}
+
+		key := product.CategoryID.String() + sizeID.String()
+
+		input := &itemdto.AddItemOrderInput{
+			OrderID:     orderID,
+			ProductID:   cartItem.ProductID,
+			QuantityID:  cartItem.QuantityID,
+			SizeID:      cartItem.SizeID,
+			Observation: cartItem.Observation,
+		}
+
+		if groupItemID, ok := groups[key]; ok {
+			input.GroupItemID = &groupItemID
+		}
+
+		ids, err := s.is.AddItemOrder(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		groups[key] = ids.GroupItemID
+
+		for _, additional := range cartItem.Additionals {
+			dtoAdditional := &itemdto.AddAdditionalItemOrderInput{ProductID: additional.ProductID, Quantity: additional.QuantityID}
+
+			if _, err := s.is.AddAdditionalItemOrder(ctx, &entitydto.IdRequest{ID: ids.ItemID}, dtoAdditional); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	idRequest := &entitydto.IdRequest{ID: orderID}
+
+	if dto.Observation != "" {
+		if err := s.os.UpdateOrderObservation(ctx, idRequest, &orderdto.UpdateObservationOrder{Observation: dto.Observation}); err != nil {
+			return nil, err
+		}
+	}
+
+	order, err := s.os.GetOrderById(ctx, idRequest)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.os.AwaitOnlinePayment(ctx, idRequest, dto.PaymentMethod); err != nil {
+		return nil, err
+	}
+
+	order.AwaitPayment(dto.PaymentMethod)
+	return order, nil
+}
@@ -0,0 +1,170 @@
+package storefrontusecases
+
+import (
+	"context"
+	"errors"
+
+	addressentity "github.com/willjrcom/sales-backend-go/internal/domain/address"
+	cliententity "github.com/willjrcom/sales-backend-go/internal/domain/client"
+	deliveryzoneentity "github.com/willjrcom/sales-backend-go/internal/domain/delivery_zone"
+	storefrontentity "github.com/willjrcom/sales-backend-go/internal/domain/storefront"
+	clientdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/client"
+	storefrontdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/storefront"
+)
+
+// RegisterCustomer creates the client with the verified phone, or returns the client already registered.
+func (s *Service) RegisterCustomer(ctx context.Context, dto *storefrontdto.RegisterCustomerInput) (*clientdto.ClientOutput, error) {
+	if client, err := s.getClient(ctx); err == nil {
+		return clientOutput(client), nil
+	}
+
+	customer, err := storefrontentity.GetCustomerFromContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := dto.ToModel(customer)
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.fillDeliveryTax(ctx, client.Address)
+
+	if err := s.rcl.RegisterClient(ctx, client); err != nil {
+		return nil, err
+	}
+
+	return clientOutput(client), nil
+}
+
+func (s *Service) GetCustomer(ctx context.Context) (*clientdto.ClientOutput, error) {
+	client, err := s.getClient(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return clientOutput(client), nil
+}
+
+func (s *Service) UpdateAddress(ctx context.Context, dto *storefrontdto.UpdateCustomerAddressInput) (*clientdto.ClientOutput, error) {
+	client, err := s.getClient(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if client.Address == nil {
+		return nil, storefrontdto.ErrAddressRequired
+	}
+
+	if err := dto.UpdateModel(client.Address); err != nil {
+		return nil, err
+	}
+
+	s.fillDeliveryTax(ctx, client.Address)
+
+	if err := s.ra.UpdateAddress(ctx, client.Address); err != nil {
+		return nil, err
+	}
+
+	return clientOutput(client), nil
+}
+
+// GetDeliveryFee returns the delivery tax of the address of the customer.
+func (s *Service) GetDeliveryFee(ctx context.Context) (*storefrontdto.DeliveryFeeOutput, error) {
+	client, err := s.getClient(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	quote, err := s.deliveryQuote(ctx, client)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &storefrontdto.DeliveryFeeOutput{
+		AddressID:     client.Address.ID,
+		DeliveryTax:   quote.Fee,
+		MinOrderValue: quote.MinOrderValue,
+		EstimatedTime: quote.EstimatedTime,
+	}, nil
+}
+
+// deliveryQuote returns the fee of the delivery zone of the address of the client.
+// Stores without zones keep the tax charged on the same neighborhood.
+func (s *Service) deliveryQuote(ctx context.Context, client *cliententity.Client) (*deliveryzoneentity.DeliveryQuote, error) {
+	if client.Address == nil {
+		return nil, storefrontdto.ErrAddressRequired
+	}
+
+	quote, err := s.zs.QuoteDelivery(ctx, client.Address)
+
+	if errors.Is(err, deliveryzoneentity.ErrAddressOutsideZones) {
+		return nil, ErrDeliveryNotAvailable
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if quote.DeliveryZoneID != nil {
+		return quote, nil
+	}
+
+	address, err := s.deliveryAddress(ctx, client)
+
+	if err != nil {
+		return nil, err
+	}
+
+	quote.Fee = address.DeliveryTax
+	return quote, nil
+}
+
+// deliveryAddress returns the address of the client when the store delivers in its neighborhood.
+func (s *Service) deliveryAddress(ctx context.Context, client *cliententity.Client) (*addressentity.Address, error) {
+	if client.Address == nil {
+		return nil, storefrontdto.ErrAddressRequired
+	}
+
+	if client.Address.DeliveryTax > 0 {
+		return client.Address, nil
+	}
+
+	if !s.fillDeliveryTax(ctx, client.Address) {
+		return nil, ErrDeliveryNotAvailable
+	}
+
+	if err := s.ra.UpdateAddress(ctx, client.Address); err != nil {
+		return nil, err
+	}
+
+	return client.Address, nil
+}
+
+// fillDeliveryTax uses the tax already charged on the same neighborhood.
+func (s *Service) fillDeliveryTax(ctx context.Context, address *addressentity.Address) bool {
+	if address == nil || address.DeliveryTax > 0 {
+		return address != nil
+	}
+
+	deliveryTax, err := s.ra.GetDeliveryTaxByNeighborhood(ctx, address.Neighborhood, address.City)
+
+	if err != nil || deliveryTax <= 0 {
+		return false
+	}
+
+	address.DeliveryTax = deliveryTax
+	return true
+}
+
+func clientOutput(client *cliententity.Client) *clientdto.ClientOutput {
+	output := &clientdto.ClientOutput{}
+	output.FromModel(client)
+	return output
+}
@@ -0,0 +1,85 @@
+package storefrontusecases
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	schemaentity "github.com/willjrcom/sales-backend-go/internal/domain/schema"
+	storefrontentity "github.com/willjrcom/sales-backend-go/internal/domain/storefront"
+	storefrontdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/storefront"
+	jwtservice "github.com/willjrcom/sales-backend-go/internal/infra/service/jwt"
+)
+
+// RequestOtp sends a code to the phone of the customer, limited by phone on each store.
+func (s *Service) RequestOtp(ctx context.Context, slug string, dto *storefrontdto.RequestOtpInput) error {
+	ddd, number, err := dto.ToModel()
+
+	if err != nil {
+		return err
+	}
+
+	company, err := s.rc.GetCompanyBySlug(ctx, slug)
+
+	if err != nil {
+		return ErrStoreNotFound
+	}
+
+	if !s.otpLimiter.Allow(company.SchemaName + ":" + ddd + number) {
+		return ErrTooManyOtpRequests
+	}
+
+	ctx = context.WithValue(ctx, schemaentity.Schema("schema"), company.SchemaName)
+
+	otp, code, err := storefrontentity.NewOtpCode(ddd, number, company.Now())
+
+	if err != nil {
+		return err
+	}
+
+	if err := s.ro.RegisterOtpCode(ctx, otp); err != nil {
+		return err
+	}
+
+	message := "Seu código de acesso " + company.TradeName + " é " + code + ". Válido por 5 minutos."
+	return s.sms.Send(ctx, "+55"+ddd+number, message)
+}
+
+// VerifyOtp returns the customer token when the code is valid, the attempt is saved even when it fails.
+// The attempts are limited by phone on each store besides the attempts of each code.
+func (s *Service) VerifyOtp(ctx context.Context, slug string, dto *storefrontdto.VerifyOtpInput) (*storefrontdto.CustomerTokenOutput, error) {
+	ddd, number, code, err := dto.ToModel()
+
+	if err != nil {
+		return nil, err
+	}
+
+	company, err := s.rc.GetCompanyBySlug(ctx, slug)
+
+	if err != nil {
+		return nil, ErrStoreNotFound
+	}
+
+	if !s.verifyLimiter.Allow(company.SchemaName + ":" + ddd + number) {
+		return nil, ErrTooManyOtpRequests
+	}
+
+	ctx = context.WithValue(ctx, schemaentity.Schema("schema"), company.SchemaName)
+
+	if err := s.ro.VerifyLastOtpCode(ctx, ddd, number, code, company.Now()); errors.Is(err, sql.ErrNoRows) {
+		return nil, storefrontentity.ErrOtpInvalid
+	} else if err != nil {
+		return nil, err
+	}
+
+	token, err := jwtservice.CreateCustomerToken(company.SchemaName, ddd, number)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = context.WithValue(ctx, storefrontentity.CustomerValue("customer"), storefrontentity.Customer{Ddd: ddd, Number: number})
+	_, errClient := s.getClient(ctx)
+
+	return &storefrontdto.CustomerTokenOutput{Token: token, Registered: errClient == nil}, nil
+}
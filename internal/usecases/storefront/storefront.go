@@ -0,0 +1,85 @@
+package storefrontusecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	addressentity "github.com/willjrcom/sales-backend-go/internal/domain/address"
+	cliententity "github.com/willjrcom/sales-backend-go/internal/domain/client"
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+	personentity "github.com/willjrcom/sales-backend-go/internal/domain/person"
+	productentity "github.com/willjrcom/sales-backend-go/internal/domain/product"
+	storefrontentity "github.com/willjrcom/sales-backend-go/internal/domain/storefront"
+	smsservice "github.com/willjrcom/sales-backend-go/internal/infra/service/sms"
+	deliveryorderusecases "github.com/willjrcom/sales-backend-go/internal/usecases/delivery_order"
+	deliveryzoneusecases "github.com/willjrcom/sales-backend-go/internal/usecases/delivery_zone"
+	itemusecases "github.com/willjrcom/sales-backend-go/internal/usecases/item"
+	orderusecases "github.com/willjrcom/sales-backend-go/internal/usecases/order"
+	pickuporderusecases "github.com/willjrcom/sales-backend-go/internal/usecases/pickup_order"
+	"github.com/willjrcom/sales-backend-go/pkg/ratelimit"
+)
+
+var (
+	ErrStoreNotFound        = errors.New("store not found")
+	ErrTooManyOtpRequests   = errors.New("too many otp requests, wait a few minutes")
+	ErrDeliveryNotAvailable = errors.New("delivery not available for this address")
+)
+
+// Service is the online ordering of the customers, identified by the phone verified by otp.
+type Service struct {
+	rc            companyentity.CompanyRepository
+	ro            storefrontentity.OtpRepository
+	rca           storefrontentity.CartRepository
+	rcl           cliententity.Repository
+	rco           personentity.ContactRepository
+	ra            addressentity.Repository
+	rp            productentity.ProductRepository
+	rq            productentity.QuantityRepository
+	sms           smsservice.Provider
+	otpLimiter    *ratelimit.Limiter
+	verifyLimiter *ratelimit.Limiter
+	os            *orderusecases.Service
+	is            *itemusecases.Service
+	ds            deliveryorderusecases.IService
+	ps            pickuporderusecases.IService
+	zs            *deliveryzoneusecases.Service
+}
+
+func NewService(rc companyentity.CompanyRepository, ro storefrontentity.OtpRepository, rca storefrontentity.CartRepository, rcl cliententity.Repository, rco personentity.ContactRepository, ra addressentity.Repository, rp productentity.ProductRepository, rq productentity.QuantityRepository, sms smsservice.Provider, os *orderusecases.Service, is *itemusecases.Service, ds deliveryorderusecases.IService, ps pickuporderusecases.IService, zs *deliveryzoneusecases.Service) *Service {
+	return &Service{
+		rc:            rc,
+		ro:            ro,
+		rca:           rca,
+		rcl:           rcl,
+		rco:           rco,
+		ra:            ra,
+		rp:            rp,
+		rq:            rq,
+		sms:           sms,
+		otpLimiter:    ratelimit.NewLimiter(3, 10*time.Minute),
+		verifyLimiter: ratelimit.NewLimiter(3*storefrontentity.OtpMaxAttempts, 10*time.Minute),
+		os:            os,
+		is:            is,
+		ds:            ds,
+		ps:            ps,
+		zs:            zs,
+	}
+}
+
+// getClient returns the client registered with the phone of the customer.
+func (s *Service) getClient(ctx context.Context) (*cliententity.Client, error) {
+	customer, err := storefrontentity.GetCustomerFromContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	contact, err := s.rco.GetContactByDddAndNumber(ctx, customer.Ddd, customer.Number, personentity.ContactTypeClient)
+
+	if err != nil {
+		return nil, storefrontentity.ErrCustomerNotRegistered
+	}
+
+	return s.rcl.GetClientById(ctx, contact.ObjectID.String())
+}
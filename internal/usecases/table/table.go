@@ -5,9 +5,12 @@ import (
 	"errors"
 
 	"github.com/google/uuid"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
 	tableentity "github.com/willjrcom/sales-backend-go/internal/domain/table"
 	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
 	tabledto "github.com/willjrcom/sales-backend-go/internal/infra/dto/table"
+	jwtservice "github.com/willjrcom/sales-backend-go/internal/infra/service/jwt"
+	qrcodeservice "github.com/willjrcom/sales-backend-go/internal/infra/service/qrcode"
 )
 
 var (
@@ -15,11 +18,12 @@ var (
 )
 
 type Service struct {
-	r tableentity.TableRepository
+	r            tableentity.TableRepository
+	selfOrderURL string
 }
 
-func NewService(c tableentity.TableRepository) *Service {
-	return &Service{r: c}
+func NewService(c tableentity.TableRepository, selfOrderURL string) *Service {
+	return &Service{r: c, selfOrderURL: selfOrderURL}
 }
 
 func (s *Service) CreateTable(ctx context.Context, dto *tabledto.CreateTableInput) (uuid.UUID, error) {
@@ -61,3 +65,79 @@ func (s *Service) GetTableById(ctx context.Context, dto *entitydto.IdRequest) (*
 func (s *Service) GetAllTables(ctx context.Context) ([]tableentity.Table, error) {
 	return s.r.GetAllTables(ctx)
 }
+
+func (s *Service) UpdateSelfOrderMode(ctx context.Context, dtoId *entitydto.IdRequest, dto *tabledto.UpdateSelfOrderModeInput) error {
+	table, err := s.r.GetTableById(ctx, dtoId.ID.String())
+
+	if err != nil {
+		return err
+	}
+
+	if err := dto.UpdateModel(table); err != nil {
+		return err
+	}
+
+	return s.r.UpdateTable(ctx, table)
+}
+
+// GetPublicToken returns the token of the table QR code, tables created before self ordering get their first nonce here.
+func (s *Service) GetPublicToken(ctx context.Context, dto *entitydto.IdRequest) (*tabledto.PublicTokenOutput, error) {
+	table, err := s.r.GetTableById(ctx, dto.ID.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if table.PublicTokenNonce == "" {
+		table.RotatePublicToken()
+
+		if err := s.r.UpdateTable(ctx, table); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.newPublicToken(ctx, table)
+}
+
+// RotatePublicToken invalidates the printed QR code of the table.
+func (s *Service) RotatePublicToken(ctx context.Context, dto *entitydto.IdRequest) (*tabledto.PublicTokenOutput, error) {
+	table, err := s.r.GetTableById(ctx, dto.ID.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	table.RotatePublicToken()
+
+	if err := s.r.UpdateTable(ctx, table); err != nil {
+		return nil, err
+	}
+
+	return s.newPublicToken(ctx, table)
+}
+
+func (s *Service) GetQRCode(ctx context.Context, dto *entitydto.IdRequest) ([]byte, error) {
+	publicToken, err := s.GetPublicToken(ctx, dto)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return qrcodeservice.EncodeSelfOrderURL(s.selfOrderURL, publicToken.Token)
+}
+
+func (s *Service) newPublicToken(ctx context.Context, table *tableentity.Table) (*tabledto.PublicTokenOutput, error) {
+	schema, err := database.GetSchema(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := jwtservice.CreateTableToken(schema, table.ID, table.PublicTokenNonce)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &tabledto.PublicTokenOutput{TableID: table.ID, Token: token}, nil
+}
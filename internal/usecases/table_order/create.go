@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
 	tableorderdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/table_order"
 )
 
@@ -18,6 +19,11 @@ func (s *Service) CreateTableOrder(ctx context.Context, dto *tableorderdto.Creat
 		return nil, err
 	}
 
+	return s.OpenTableOrder(ctx, tableOrder)
+}
+
+// OpenTableOrder creates the order of the table order and locks the table.
+func (s *Service) OpenTableOrder(ctx context.Context, tableOrder *orderentity.TableOrder) (*tableorderdto.TableIDAndOrderIDOutput, error) {
 	orderID, err := s.os.CreateDefaultOrder(ctx)
 
 	if err != nil {
@@ -64,3 +64,22 @@ func (s *Service) FinishTableOrder(ctx context.Context, dtoID *entitydto.IdReque
 
 	return s.rt.UpdateTable(ctx, table)
 }
+
+// ApproveSelfOrder sends to the kitchen the items ordered by the customer on the table QR code.
+func (s *Service) ApproveSelfOrder(ctx context.Context, dtoID *entitydto.IdRequest) error {
+	tableOrder, err := s.rto.GetTableOrderById(ctx, dtoID.ID.String())
+
+	if err != nil {
+		return err
+	}
+
+	if err := tableOrder.Approve(); err != nil {
+		return err
+	}
+
+	if err := s.os.PendingOrder(ctx, &entitydto.IdRequest{ID: tableOrder.OrderID}); err != nil {
+		return err
+	}
+
+	return s.rto.UpdateTableOrder(ctx, tableOrder)
+}
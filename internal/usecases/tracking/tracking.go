@@ -0,0 +1,55 @@
+package trackingusecases
+
+import (
+	"context"
+	"errors"
+
+	employeeentity "github.com/willjrcom/sales-backend-go/internal/domain/employee"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+	schemaentity "github.com/willjrcom/sales-backend-go/internal/domain/schema"
+	trackingdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/tracking"
+	jwtservice "github.com/willjrcom/sales-backend-go/internal/infra/service/jwt"
+	etausecases "github.com/willjrcom/sales-backend-go/internal/usecases/eta"
+)
+
+var (
+	ErrTrackingTokenInvalid = errors.New("tracking link is invalid or expired")
+)
+
+// Service is the public tracking of the order by the customer, every call is authorized by the tracking token.
+type Service struct {
+	ro orderentity.OrderRepository
+	re employeeentity.Repository
+	es *etausecases.Service
+}
+
+func NewService(ro orderentity.OrderRepository, re employeeentity.Repository, es *etausecases.Service) *Service {
+	return &Service{ro: ro, re: re, es: es}
+}
+
+func (s *Service) GetTracking(ctx context.Context, token string) (*trackingdto.TrackingOutput, error) {
+	schema, orderID, err := jwtservice.ValidateOrderTrackingToken(ctx, token)
+
+	if err != nil {
+		return nil, ErrTrackingTokenInvalid
+	}
+
+	ctx = context.WithValue(ctx, schemaentity.Schema("schema"), schema)
+
+	order, err := s.ro.GetOrderById(ctx, orderID.String())
+
+	if err != nil {
+		return nil, ErrTrackingTokenInvalid
+	}
+
+	var driver *employeeentity.Employee
+	if order.Delivery != nil && order.Delivery.DriverID != nil {
+		driver, _ = s.re.GetEmployeeById(ctx, order.Delivery.DriverID.String())
+	}
+
+	s.es.EstimateOrders(ctx, order)
+
+	output := &trackingdto.TrackingOutput{}
+	output.FromModel(order, driver)
+	return output, nil
+}
@@ -17,6 +17,11 @@ var rootCmd = &cobra.Command{
 
 func main() {
 	rootCmd.PersistentFlags().StringP("port", "p", ":8080", "the port to connect to server")
+	rootCmd.PersistentFlags().String("cnpj-provider", "receitaws", "the cnpj lookup provider: receitaws or fixture")
+	rootCmd.PersistentFlags().String("cep-provider", "viacep", "the cep lookup provider: viacep or fixture")
+	rootCmd.PersistentFlags().String("storage-provider", "s3", "the file storage provider: s3 or fake")
+	rootCmd.PersistentFlags().String("sms-provider", "twilio", "the sms provider: twilio, credentials on TWILIO_ACCOUNT_SID, TWILIO_AUTH_TOKEN and TWILIO_FROM, or fake")
+	rootCmd.PersistentFlags().String("self-order-url", "http://localhost:3000/self-order/", "the self-ordering page opened by the table QR code")
 	rootCmd.AddCommand(cmd.HttpserverCmd)
 
 	ctx := context.Background()
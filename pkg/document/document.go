@@ -0,0 +1,152 @@
+package document
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+)
+
+var (
+	ErrCpfInvalid  = errors.New("cpf is invalid")
+	ErrCnpjInvalid = errors.New("cnpj is invalid")
+)
+
+const (
+	cpfLength  = 11
+	cnpjLength = 14
+)
+
+// NormalizeCpf returns the 11 digits of the cpf after verifying the check digits.
+func NormalizeCpf(cpf string) (string, error) {
+	digits := strings.Map(keepDigit, cpf)
+
+	if len(digits) != cpfLength || isRepeated(digits) {
+		return "", ErrCpfInvalid
+	}
+
+	values := charValues(digits)
+
+	for _, position := range []int{9, 10} {
+		if checkDigit(values[:position], cpfLength) != values[position] {
+			return "", ErrCpfInvalid
+		}
+	}
+
+	return digits, nil
+}
+
+// FormatCpf returns the cpf as 000.000.000-00.
+func FormatCpf(cpf string) (string, error) {
+	digits, err := NormalizeCpf(cpf)
+
+	if err != nil {
+		return "", err
+	}
+
+	return digits[0:3] + "." + digits[3:6] + "." + digits[6:9] + "-" + digits[9:], nil
+}
+
+func ValidateCpf(cpf string) error {
+	_, err := NormalizeCpf(cpf)
+	return err
+}
+
+// NormalizeCnpj returns the 14 characters of the cnpj in upper case after verifying the check digits,
+// the first 12 characters may be letters on the alphanumeric cnpj, the check digits are always numbers.
+func NormalizeCnpj(cnpj string) (string, error) {
+	chars := strings.Map(removeSeparator, strings.ToUpper(cnpj))
+
+	if len(chars) != cnpjLength || isRepeated(chars) {
+		return "", ErrCnpjInvalid
+	}
+
+	for i, r := range chars {
+		if !isDigit(r) && (i >= 12 || r < 'A' || r > 'Z') {
+			return "", ErrCnpjInvalid
+		}
+	}
+
+	values := charValues(chars)
+
+	for _, position := range []int{12, 13} {
+		if checkDigit(values[:position], 9) != values[position] {
+			return "", ErrCnpjInvalid
+		}
+	}
+
+	return chars, nil
+}
+
+// FormatCnpj returns the cnpj as 00.000.000/0000-00.
+func FormatCnpj(cnpj string) (string, error) {
+	chars, err := NormalizeCnpj(cnpj)
+
+	if err != nil {
+		return "", err
+	}
+
+	return chars[0:2] + "." + chars[2:5] + "." + chars[5:8] + "/" + chars[8:12] + "-" + chars[12:], nil
+}
+
+func ValidateCnpj(cnpj string) error {
+	_, err := NormalizeCnpj(cnpj)
+	return err
+}
+
+// checkDigit computes the modulo 11 check digit, the weights go up from 2 starting at the last value
+// and start again from 2 after the max weight.
+func checkDigit(values []int, maxWeight int) int {
+	sum := 0
+	weight := 2
+
+	for i := len(values) - 1; i >= 0; i-- {
+		sum += values[i] * weight
+
+		weight++
+		if weight > maxWeight {
+			weight = 2
+		}
+	}
+
+	remainder := sum % 11
+	if remainder < 2 {
+		return 0
+	}
+
+	return 11 - remainder
+}
+
+// charValues converts each character to its ascii code minus 48, digits keep their value and A is 17.
+func charValues(chars string) []int {
+	values := make([]int, len(chars))
+
+	for i, r := range chars {
+		values[i] = int(r) - '0'
+	}
+
+	return values
+}
+
+func isRepeated(chars string) bool {
+	return strings.Count(chars, chars[:1]) == len(chars)
+}
+
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+func keepDigit(r rune) rune {
+	if isDigit(r) {
+		return r
+	}
+
+	return -1
+}
+
+func removeSeparator(r rune) rune {
+	if unicode.IsSpace(r) || r == '.' || r == '/' || r == '-' {
+		return -1
+	}
+
+	return r
+}
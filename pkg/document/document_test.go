@@ -0,0 +1,45 @@
+package document
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCpf(t *testing.T) {
+	cpf, err := FormatCpf("52998224725")
+	assert.Nil(t, err)
+	assert.Equal(t, "529.982.247-25", cpf)
+
+	cpf, err = NormalizeCpf(" 529.982.247-25 ")
+	assert.Nil(t, err)
+	assert.Equal(t, "52998224725", cpf)
+
+	assert.EqualError(t, ValidateCpf("529.982.247-24"), ErrCpfInvalid.Error())
+	assert.EqualError(t, ValidateCpf("111.111.111-11"), ErrCpfInvalid.Error())
+	assert.EqualError(t, ValidateCpf("5299822472"), ErrCpfInvalid.Error())
+}
+
+func TestCnpj(t *testing.T) {
+	cnpj, err := FormatCnpj("11222333000181")
+	assert.Nil(t, err)
+	assert.Equal(t, "11.222.333/0001-81", cnpj)
+
+	assert.EqualError(t, ValidateCnpj("11.222.333/0001-82"), ErrCnpjInvalid.Error())
+	assert.EqualError(t, ValidateCnpj("00.000.000/0000-00"), ErrCnpjInvalid.Error())
+	assert.EqualError(t, ValidateCnpj("11.222.333/0001-8"), ErrCnpjInvalid.Error())
+}
+
+func TestAlphanumericCnpj(t *testing.T) {
+	cnpj, err := FormatCnpj("12abc34501de35")
+	assert.Nil(t, err)
+	assert.Equal(t, "12.ABC.345/01DE-35", cnpj)
+
+	cnpj, err = NormalizeCnpj("12.ABC.345/01DE-35")
+	assert.Nil(t, err)
+	assert.Equal(t, "12ABC34501DE35", cnpj)
+
+	assert.EqualError(t, ValidateCnpj("12.ABC.345/01DE-36"), ErrCnpjInvalid.Error())
+	assert.EqualError(t, ValidateCnpj("12.ABC.345/01DE-3A"), ErrCnpjInvalid.Error())
+	assert.EqualError(t, ValidateCnpj("12.AB#.345/01DE-35"), ErrCnpjInvalid.Error())
+}
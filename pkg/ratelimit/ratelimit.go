@@ -0,0 +1,59 @@
+package ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+// Limiter allows a fixed number of hits per key on each window.
+type Limiter struct {
+	mu      sync.Mutex
+	limit   int
+	window  time.Duration
+	windows map[string]*window
+	now     func() time.Time
+}
+
+type window struct {
+	start time.Time
+	hits  int
+}
+
+func NewLimiter(limit int, interval time.Duration) *Limiter {
+	return &Limiter{
+		limit:   limit,
+		window:  interval,
+		windows: map[string]*window{},
+		now:     time.Now,
+	}
+}
+
+func (l *Limiter) Allow(key string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	w, ok := l.windows[key]
+
+	if !ok || now.Sub(w.start) >= l.window {
+		l.cleanup(now)
+		l.windows[key] = &window{start: now, hits: 1}
+		return true
+	}
+
+	if w.hits >= l.limit {
+		return false
+	}
+
+	w.hits++
+	return true
+}
+
+// cleanup removes expired windows so the map does not grow with old keys.
+func (l *Limiter) cleanup(now time.Time) {
+	for key, w := range l.windows {
+		if now.Sub(w.start) >= l.window {
+			delete(l.windows, key)
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLimiter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	limiter := NewLimiter(2, time.Minute)
+	limiter.now = func() time.Time { return now }
+
+	assert.True(t, limiter.Allow("table-1"))
+	assert.True(t, limiter.Allow("table-1"))
+	assert.False(t, limiter.Allow("table-1"))
+	assert.True(t, limiter.Allow("table-2"))
+
+	now = now.Add(time.Minute)
+	assert.True(t, limiter.Allow("table-1"))
+}